@@ -0,0 +1,245 @@
+package cloudview
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/Tsahi-Elkayam/cloudview/pkg/cache"
+)
+
+// NewCacheCommand creates the cache management command
+func NewCacheCommand(logger *logrus.Logger) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cache",
+		Short: "Manage the resource discovery cache",
+		Long: `Manage the cache of resource discovery results.
+
+CloudView caches inventory results for the configured TTL so repeated queries
+against the same accounts don't re-scan every AWS API. With 'cache.storage: disk'
+results are shared between separate CLI invocations.
+
+Examples:
+  # Show cache usage
+  cloudview cache stats
+
+  # List cached entries
+  cloudview cache ls
+
+  # Remove expired entries only
+  cloudview cache clear --expired
+
+  # Remove everything
+  cloudview cache clear`,
+	}
+
+	cmd.AddCommand(NewCacheStatsCommand(logger))
+	cmd.AddCommand(NewCacheListCommand(logger))
+	cmd.AddCommand(NewCacheClearCommand(logger))
+
+	return cmd
+}
+
+// NewCacheStatsCommand shows cache usage statistics
+func NewCacheStatsCommand(logger *logrus.Logger) *cobra.Command {
+	var format string
+
+	cmd := &cobra.Command{
+		Use:   "stats",
+		Short: "Show cache usage statistics",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			store, err := openCacheStore()
+			if err != nil {
+				return err
+			}
+
+			stats, err := store.Stats()
+			if err != nil {
+				return fmt.Errorf("failed to read cache statistics: %w", err)
+			}
+
+			if strings.ToLower(format) == "json" {
+				return NewJSONEncoder(os.Stdout).Encode(stats)
+			}
+
+			cfg := GetGlobalConfig()
+			fmt.Printf("💾 Cache Statistics\n")
+			fmt.Printf("===================\n\n")
+			fmt.Printf("   Enabled: %v\n", cfg.Cache.Enabled)
+			fmt.Printf("   Storage: %s\n", stats.Storage)
+			if stats.Directory != "" {
+				fmt.Printf("   Directory: %s\n", stats.Directory)
+			}
+			fmt.Printf("   TTL: %v\n", cfg.Cache.TTL)
+			fmt.Printf("   Entries: %d (%d expired)\n", stats.Entries, stats.Expired)
+			if stats.MaxSize > 0 {
+				usage := float64(stats.TotalSize) / float64(stats.MaxSize) * 100
+				fmt.Printf("   Size: %s of %s (%.1f%%)\n", cache.FormatSize(stats.TotalSize), cache.FormatSize(stats.MaxSize), usage)
+			} else {
+				fmt.Printf("   Size: %s (unbounded)\n", cache.FormatSize(stats.TotalSize))
+			}
+
+			printMemoryCacheNote(stats.Storage)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&format, "format", "table", "Output format (table, json)")
+
+	return cmd
+}
+
+// NewCacheListCommand lists cache entries
+func NewCacheListCommand(logger *logrus.Logger) *cobra.Command {
+	var format string
+
+	cmd := &cobra.Command{
+		Use:     "ls",
+		Aliases: []string{"list"},
+		Short:   "List cached entries",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			store, err := openCacheStore()
+			if err != nil {
+				return err
+			}
+
+			entries, err := store.Entries()
+			if err != nil {
+				return fmt.Errorf("failed to list cache entries: %w", err)
+			}
+
+			// Most recently used first
+			sort.Slice(entries, func(i, j int) bool {
+				return entries[i].LastAccessed.After(entries[j].LastAccessed)
+			})
+
+			if strings.ToLower(format) == "json" {
+				return NewJSONEncoder(os.Stdout).Encode(entries)
+			}
+
+			if len(entries) == 0 {
+				fmt.Println("Cache is empty.")
+				printMemoryCacheNote(cacheStorageName())
+				return nil
+			}
+
+			now := time.Now()
+			fmt.Printf("%-16s  %-10s  %-12s  %-12s  %s\n", "KEY", "SIZE", "AGE", "LAST USED", "EXPIRES")
+			fmt.Printf("%s  %s  %s  %s  %s\n",
+				strings.Repeat("-", 16), strings.Repeat("-", 10), strings.Repeat("-", 12),
+				strings.Repeat("-", 12), strings.Repeat("-", 12))
+			for _, entry := range entries {
+				expires := "in " + formatDuration(entry.ExpiresAt.Sub(now))
+				if entry.Expired() {
+					expires = "expired"
+				}
+
+				fmt.Printf("%-16s  %-10s  %-12s  %-12s  %s\n",
+					truncateString(entry.Key, 16),
+					cache.FormatSize(entry.Size),
+					formatDuration(now.Sub(entry.CreatedAt)),
+					formatDuration(now.Sub(entry.LastAccessed))+" ago",
+					expires)
+			}
+
+			fmt.Printf("\nTotal entries: %d\n", len(entries))
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&format, "format", "table", "Output format (table, json)")
+
+	return cmd
+}
+
+// NewCacheClearCommand removes cache entries
+func NewCacheClearCommand(logger *logrus.Logger) *cobra.Command {
+	var expiredOnly bool
+
+	cmd := &cobra.Command{
+		Use:   "clear",
+		Short: "Remove cached entries",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			store, err := openCacheStore()
+			if err != nil {
+				return err
+			}
+
+			if expiredOnly {
+				removed, err := store.Prune()
+				if err != nil {
+					return fmt.Errorf("failed to prune cache: %w", err)
+				}
+				fmt.Printf("✅ Removed %d expired cache entries\n", removed)
+				return nil
+			}
+
+			if err := store.Clear(); err != nil {
+				return fmt.Errorf("failed to clear cache: %w", err)
+			}
+
+			fmt.Printf("✅ Cache cleared\n")
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&expiredOnly, "expired", false, "Only remove expired entries")
+
+	return cmd
+}
+
+// openCacheStore opens the cache store described by the global configuration
+func openCacheStore() (cache.Store, error) {
+	cfg := GetGlobalConfig()
+	if cfg == nil {
+		return nil, fmt.Errorf("configuration not loaded")
+	}
+
+	store, err := cache.NewStore(cfg.Cache)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open cache: %w", err)
+	}
+
+	return store, nil
+}
+
+// cacheStorageName returns the configured cache storage backend
+func cacheStorageName() string {
+	if cfg := GetGlobalConfig(); cfg != nil {
+		return cfg.Cache.Storage
+	}
+	return ""
+}
+
+// printMemoryCacheNote explains that memory caches don't outlive a single invocation
+func printMemoryCacheNote(storage string) {
+	if storage != "memory" {
+		return
+	}
+
+	fmt.Printf("\n💡 The memory cache only lives for a single command.\n")
+	fmt.Printf("   Set 'cache.storage: disk' to share results between invocations.\n")
+}
+
+// formatDuration formats a duration in a compact human-readable form
+func formatDuration(d time.Duration) string {
+	if d < 0 {
+		d = -d
+	}
+
+	switch {
+	case d < time.Minute:
+		return fmt.Sprintf("%ds", int(d.Seconds()))
+	case d < time.Hour:
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	case d < 24*time.Hour:
+		return fmt.Sprintf("%dh%dm", int(d.Hours()), int(d.Minutes())%60)
+	default:
+		return fmt.Sprintf("%dd%dh", int(d.Hours())/24, int(d.Hours())%24)
+	}
+}
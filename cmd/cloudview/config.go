@@ -11,6 +11,7 @@ import (
 	"github.com/spf13/cobra"
 	"gopkg.in/yaml.v3"
 
+	"github.com/Tsahi-Elkayam/cloudview/pkg/cache"
 	"github.com/Tsahi-Elkayam/cloudview/pkg/config"
 )
 
@@ -357,6 +358,13 @@ func showConfigTable(cfg *config.Config, showDefaults bool) error {
 		fmt.Printf("   TTL: %v\n", cfg.Cache.TTL)
 		fmt.Printf("   Storage: %s\n", cfg.Cache.Storage)
 		fmt.Printf("   Max Size: %s\n", cfg.Cache.MaxSize)
+		if cfg.Cache.Storage == "disk" {
+			directory := cfg.Cache.Directory
+			if directory == "" {
+				directory = cache.DefaultDirectory()
+			}
+			fmt.Printf("   Directory: %s\n", directory)
+		}
 	}
 	fmt.Printf("\n")
 
@@ -0,0 +1,214 @@
+package cloudview
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/Tsahi-Elkayam/cloudview/pkg/models"
+	"github.com/Tsahi-Elkayam/cloudview/pkg/output"
+	"github.com/Tsahi-Elkayam/cloudview/pkg/providers"
+)
+
+// DescribeOptions holds options for the describe command
+type DescribeOptions struct {
+	Providers     []string
+	Regions       []string
+	ResourceTypes []string
+	Output        string
+	Verbose       bool
+	Refresh       bool
+}
+
+// NewDescribeCommand creates the describe command
+func NewDescribeCommand(logger *logrus.Logger) *cobra.Command {
+	opts := &DescribeOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "describe <id|name|arn>",
+		Short: "Show everything about a single resource",
+		Long: `Show a detailed view of one resource: all tags, metadata grouped into network,
+security and storage sections, its live status from the provider, and related
+resources referenced in its metadata (VPC, subnet, security groups) or that
+reference it.
+
+The resource is located by ID, ARN or name across every enabled provider and
+region. If a name matches several resources, narrow it with --type or --region.
+
+Examples:
+  # Describe an instance
+  cloudview describe i-0abc123def4567890
+
+  # Describe a database by name, or by ARN
+  cloudview describe orders-db --type rds
+  cloudview describe arn:aws:rds:us-east-1:123456789012:db:orders-db
+
+  # Which instances use this security group?
+  cloudview describe sg-0123456789abcdef0
+
+  # Machine-readable description
+  cloudview describe i-0abc123def4567890 --output json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runDescribeCommand(cmd.Context(), args[0], opts, logger)
+		},
+	}
+
+	cmd.Flags().StringSliceVarP(&opts.Providers, "provider", "p", []string{"all"},
+		"Cloud providers to search (aws, all)")
+	cmd.Flags().StringSliceVarP(&opts.Regions, "region", "r", []string{},
+		"Regions to search (comma-separated)")
+	cmd.Flags().StringSliceVarP(&opts.ResourceTypes, "type", "t", []string{},
+		"Resource types to search (ec2,rds,security_group,etc)")
+	cmd.Flags().StringVarP(&opts.Output, "output", "o", "text",
+		"Output format (text, json, yaml)")
+	cmd.Flags().BoolVarP(&opts.Verbose, "verbose", "v", false,
+		"Verbose output")
+	cmd.Flags().BoolVar(&opts.Refresh, "refresh", false,
+		"Bypass cached results and query the cloud provider APIs")
+
+	return cmd
+}
+
+// runDescribeCommand executes the describe command
+func runDescribeCommand(ctx context.Context, term string, opts *DescribeOptions, logger *logrus.Logger) error {
+	cfg := GetGlobalConfig()
+	if cfg == nil {
+		return fmt.Errorf("configuration not loaded")
+	}
+
+	term = strings.TrimSpace(term)
+	if term == "" {
+		return fmt.Errorf("resource ID, name or ARN cannot be empty")
+	}
+
+	format := strings.ToLower(opts.Output)
+	switch format {
+	case "text", "json", "yaml":
+	default:
+		return fmt.Errorf("unsupported output format: %s (supported: text, json, yaml)", opts.Output)
+	}
+
+	// Keep stdout clean for machine-readable output
+	status := io.Writer(os.Stdout)
+	if format != "text" {
+		status = os.Stderr
+	}
+
+	filters, err := parseInventoryFilters(&InventoryOptions{
+		Regions:       opts.Regions,
+		ResourceTypes: opts.ResourceTypes,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to parse filters: %w", err)
+	}
+
+	if opts.Verbose {
+		logger.SetLevel(logrus.DebugLevel)
+		logger.Debugf("Using filters: %+v", filters)
+	}
+
+	queried, ok := createProviders(ctx, cfg, opts.Providers, opts.Refresh, status, logger)
+	if !ok {
+		return nil
+	}
+
+	// Related resources are looked up in the whole inventory, so every
+	// resource is kept along with the provider that found it
+	var inventory []models.Resource
+	owners := make(map[string]providers.CloudProvider)
+	for _, provider := range queried {
+		fmt.Fprintf(status, "🔍 Searching %s resources...\n", provider.Name())
+		resources, err := provider.GetResources(ctx, filters)
+		if err != nil {
+			logger.Errorf("Failed to get resources from provider %s: %v", provider.Name(), err)
+			fmt.Fprintf(status, "❌ Failed to get resources from %s: %v\n", provider.Name(), err)
+			continue
+		}
+		inventory = append(inventory, resources...)
+		owners[provider.Name()] = provider
+	}
+
+	matches := locateResources(inventory, term)
+	switch len(matches) {
+	case 0:
+		return fmt.Errorf("no resource matches %q (try 'cloudview search %s' for partial matches)", term, term)
+	case 1:
+	default:
+		fmt.Fprintf(status, "\n⚠️  %d resources match %q:\n\n", len(matches), term)
+		writeCandidates(status, matches)
+		return fmt.Errorf("%q is ambiguous: describe one of the IDs or ARNs above, or narrow with --type or --region", term)
+	}
+
+	resource := matches[0]
+	description := output.NewDescription(resource, inventory)
+
+	// Status from discovery may be cached, so ask the provider directly. The
+	// ARN pins the lookup to the resource's own kind and region, where a
+	// plain ID could match another kind of resource with the same name.
+	if provider, ok := owners[resource.Provider]; ok {
+		lookupID := resource.ID
+		if resource.ARN != "" {
+			lookupID = resource.ARN
+		}
+		liveStatus, err := provider.GetResourceStatus(ctx, lookupID)
+		if err != nil {
+			logger.Debugf("Failed to get live status of %s: %v", lookupID, err)
+			description.StatusError = err.Error()
+		} else {
+			description.LiveStatus = liveStatus
+		}
+	}
+
+	switch format {
+	case "json":
+		return NewJSONEncoder(os.Stdout).Encode(description)
+	case "yaml":
+		return NewYAMLEncoder(os.Stdout).Encode(description)
+	}
+
+	fmt.Printf("\n")
+	return output.WriteDescription(os.Stdout, description)
+}
+
+// locateResources finds the resources identified by term. IDs and ARNs take
+// precedence over names, and exact matches over case-insensitive ones.
+func locateResources(resources []models.Resource, term string) []models.Resource {
+	matchers := []func(models.Resource) bool{
+		func(r models.Resource) bool { return r.ID == term || r.ARN == term },
+		func(r models.Resource) bool { return strings.EqualFold(r.ID, term) || strings.EqualFold(r.ARN, term) },
+		func(r models.Resource) bool { return r.Name == term },
+		func(r models.Resource) bool { return strings.EqualFold(r.Name, term) },
+	}
+
+	for _, matches := range matchers {
+		var found []models.Resource
+		for _, resource := range resources {
+			if matches(resource) {
+				found = append(found, resource)
+			}
+		}
+		if len(found) > 0 {
+			output.SortResources(found, output.DefaultSortKeys)
+			return found
+		}
+	}
+	return nil
+}
+
+// writeCandidates lists the resources an ambiguous term matched
+func writeCandidates(w io.Writer, resources []models.Resource) {
+	tw := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(tw, "   ID\tNAME\tTYPE\tREGION\tARN")
+	for _, resource := range resources {
+		fmt.Fprintf(tw, "   %s\t%s\t%s\t%s\t%s\n", resource.ID, resource.Name, resource.Type, resource.Region, resource.ARN)
+	}
+	tw.Flush()
+	fmt.Fprintln(w)
+}
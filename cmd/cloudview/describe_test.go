@@ -0,0 +1,55 @@
+package cloudview
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Tsahi-Elkayam/cloudview/pkg/models"
+)
+
+func TestLocateResources(t *testing.T) {
+	resources := []models.Resource{
+		{ID: "i-1", Name: "web", Type: "virtual_machine", Region: "us-east-1"},
+		{ID: "i-2", Name: "web", Type: "virtual_machine", Region: "us-west-2"},
+		{ID: "db-1", Name: "i-1", Type: "database", Region: "us-east-1",
+			ARN: "arn:aws:rds:us-east-1:123456789012:db:db-1"},
+		{ID: "Orders", Name: "orders-db", Type: "database", Region: "us-east-1"},
+	}
+
+	tests := []struct {
+		name     string
+		term     string
+		expected []string
+	}{
+		{"ID wins over name", "i-1", []string{"i-1"}},
+		{"ARN", "arn:aws:rds:us-east-1:123456789012:db:db-1", []string{"db-1"}},
+		{"case-insensitive ID", "orders", []string{"Orders"}},
+		{"ambiguous name", "web", []string{"i-1", "i-2"}},
+		{"case-insensitive name", "ORDERS-DB", []string{"Orders"}},
+		{"no match", "i-3", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ids []string
+			for _, resource := range locateResources(resources, tt.term) {
+				ids = append(ids, resource.ID)
+			}
+			assert.Equal(t, tt.expected, ids)
+		})
+	}
+}
+
+func TestWriteCandidatesShowsARNs(t *testing.T) {
+	var buf bytes.Buffer
+	writeCandidates(&buf, []models.Resource{
+		{ID: "orders", Name: "orders", Type: "database", Region: "us-east-1", ARN: "arn:aws:rds:us-east-1:123456789012:db:orders"},
+		{ID: "orders", Name: "orders", Type: "database", Region: "us-east-1", ARN: "arn:aws:rds:us-east-1:123456789012:cluster:orders"},
+	})
+
+	assert.Contains(t, buf.String(), "ARN")
+	assert.Contains(t, buf.String(), "arn:aws:rds:us-east-1:123456789012:db:orders")
+	assert.Contains(t, buf.String(), "arn:aws:rds:us-east-1:123456789012:cluster:orders")
+}
@@ -3,15 +3,20 @@ package cloudview
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
+	"sort"
 	"strings"
+	"text/template"
 	"time"
 
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 
+	"github.com/Tsahi-Elkayam/cloudview/pkg/cache"
 	"github.com/Tsahi-Elkayam/cloudview/pkg/config"
 	"github.com/Tsahi-Elkayam/cloudview/pkg/models"
+	"github.com/Tsahi-Elkayam/cloudview/pkg/output"
 	"github.com/Tsahi-Elkayam/cloudview/pkg/providers"
 	"github.com/Tsahi-Elkayam/cloudview/pkg/types"
 )
@@ -23,7 +28,14 @@ type InventoryOptions struct {
 	ResourceTypes []string
 	Tags          []string
 	Status        []string
+	Filter        string
+	View          string
 	Output        string
+	OutputFile    string
+	Query         string
+	SortBy        []string
+	GroupBy       string
+	Details       bool
 	CreatedAfter  string
 	CreatedBefore string
 	NoHeader      bool
@@ -31,6 +43,7 @@ type InventoryOptions struct {
 	Wide          bool  // New: Wide table format
 	MaxWidth      int   // New: Maximum table width
 	NoTruncate    bool  // New: Don't truncate long names
+	Refresh       bool  // Bypass cached results
 }
 
 // NewInventoryCommand creates the inventory command
@@ -54,14 +67,67 @@ Examples:
   cloudview inventory --provider aws --type ec2 --region us-east-1,us-west-2 --wide
 
   # List resources with specific tags (full names, no truncation)
-  cloudview inventory --provider aws --tag Environment=prod,Team=backend --no-truncate
+  cloudview inventory --provider aws --tag Environment=prod --tag Team=backend --no-truncate
+
+  # Filter with an expression over any field, tag or metadata value
+  cloudview inventory --provider aws --filter 'type == "virtual_machine" && metadata.instance_type =~ "^t3" && !has(tags.Owner) && created_at < now-90d'
+
+  # Find resources missing an Owner tag, in prod or staging
+  cloudview inventory --provider aws --tag '!Owner' --tag 'Environment=prod|staging'
 
   # List resources created in the last 7 days with custom table width
   cloudview inventory --provider aws --created-after 2024-01-01 --max-width 200
 
   # Export everything to JSON for analysis
-  cloudview inventory --provider aws --output json > infrastructure.json`,
+  cloudview inventory --provider aws --output json > infrastructure.json
+
+  # Stream resources as JSON Lines while they are discovered
+  cloudview inventory --provider aws --output jsonl | jq -r 'select(.status.state == "stopped") | .id'
+
+  # Export CSV with one column per tag and metadata field
+  cloudview inventory --provider aws --output csv --output-file inventory.csv
+
+  # Extract the public IPs of running instances with a JMESPath query
+  cloudview inventory --provider aws --type ec2 --output json \
+    --query "resources[?status.state=='running'].metadata.public_ip"
+
+  # Group by team tag, newest first within each group
+  cloudview inventory --provider aws --group-by tag:Team --sort-by -created_at
+
+  # Choose the table columns, including tags and metadata fields
+  cloudview inventory --provider aws --type ec2 -o custom-columns=ID:.id,TYPE:.metadata.instance_type,OWNER:.tags.Owner
+
+  # Render resources with a Go template
+  cloudview inventory --provider aws -o go-template='{{range .resources}}{{.id}} {{.region}}{{"\n"}}{{end}}'
+
+  # Share an offline HTML report
+  cloudview inventory --provider aws --output html --output-file inventory.html
+
+  # Post a markdown summary of tagged resources to a pull request
+  cloudview inventory --provider aws --tag Project=X --output markdown --details
+
+  # Export a spreadsheet with one sheet per resource type
+  cloudview inventory --provider aws --output excel --output-file inventory.xlsx
+
+  # Run a view saved in the configuration file, overriding its regions
+  cloudview inventory --view prod-databases --region eu-west-1
+
+  # Ignore cached results and query AWS again
+  cloudview inventory --provider aws --refresh`,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			// Start from a saved view; explicit flags still take precedence
+			if opts.View != "" {
+				if err := applyView(cmd, opts.View); err != nil {
+					return err
+				}
+			}
+
+			// Fall back to the configured output format
+			if !cmd.Flags().Changed("output") {
+				if cfg := GetGlobalConfig(); cfg != nil && cfg.Output.Format != "" {
+					opts.Output = cfg.Output.Format
+				}
+			}
 			return runInventoryCommand(cmd.Context(), opts, logger)
 		},
 	}
@@ -74,11 +140,16 @@ Examples:
 	cmd.Flags().StringSliceVarP(&opts.Regions, "region", "r", []string{},
 		"Regions to query (comma-separated)")
 	cmd.Flags().StringSliceVarP(&opts.ResourceTypes, "type", "t", []string{},
-		"Resource types to filter (ec2,s3,lambda,etc)")
-	cmd.Flags().StringSliceVar(&opts.Tags, "tag", []string{},
-		"Tags to filter by (format: key=value)")
+		"Resource types to filter: normalized types (virtual_machine,database), aliases (ec2,s3,rds) or native types (AWS::EC2::Instance)")
+	cmd.Flags().StringArrayVar(&opts.Tags, "tag", []string{},
+		"Tags to filter by: key=value, key (exists), !key (absent), key=a|b, key=web-* or key=~regex")
 	cmd.Flags().StringSliceVarP(&opts.Status, "status", "s", []string{},
-		"Resource status to filter by (running,stopped,etc)")
+		"Resource status to filter by (running,stopped,etc; active/inactive match any provider's equivalent states)")
+
+	cmd.Flags().StringVar(&opts.View, "view", "",
+		"Start from a saved view (see 'cloudview view list'); other flags override it")
+	cmd.Flags().StringVar(&opts.Filter, "filter", "",
+		"Filter expression, e.g. 'type == \"virtual_machine\" && metadata.instance_type =~ \"^t3\" && !has(tags.Owner)'")
 
 	// Time filtering
 	cmd.Flags().StringVar(&opts.CreatedAfter, "created-after", "",
@@ -88,7 +159,17 @@ Examples:
 
 	// Output options
 	cmd.Flags().StringVarP(&opts.Output, "output", "o", "table",
-		"Output format (table,json,yaml)")
+		"Output format (table,json,jsonl,yaml,csv,tsv,html,markdown,excel,go-template=...,go-template-file=...,custom-columns=...)")
+	cmd.Flags().StringVar(&opts.Query, "query", "",
+		"JMESPath query applied to the output document (e.g. \"resources[?status.state=='running'].id\")")
+	cmd.Flags().StringSliceVar(&opts.SortBy, "sort-by", []string{},
+		"Fields to sort by, e.g. region,-created_at,tags.Team,metadata.instance_type:desc")
+	cmd.Flags().StringVar(&opts.GroupBy, "group-by", "",
+		"Group table output by a field (region, type, provider, tag:Team, ...)")
+	cmd.Flags().StringVar(&opts.OutputFile, "output-file", "",
+		"Write output to a file instead of stdout (excel defaults to cloudview-inventory-<timestamp>.xlsx)")
+	cmd.Flags().BoolVar(&opts.Details, "details", false,
+		"Include collapsible metadata blocks (markdown output)")
 	cmd.Flags().BoolVar(&opts.NoHeader, "no-header", false,
 		"Don't print column headers")
 	cmd.Flags().BoolVarP(&opts.Verbose, "verbose", "v", false,
@@ -102,6 +183,10 @@ Examples:
 	cmd.Flags().BoolVar(&opts.NoTruncate, "no-truncate", false,
 		"Don't truncate long resource names and values")
 
+	// Cache options
+	cmd.Flags().BoolVar(&opts.Refresh, "refresh", false,
+		"Bypass cached results and query the cloud provider APIs")
+
 	return cmd
 }
 
@@ -119,23 +204,193 @@ func runInventoryCommand(ctx context.Context, opts *InventoryOptions, logger *lo
 		return fmt.Errorf("failed to parse filters: %w", err)
 	}
 
+	// Catch bad formats, templates and queries before querying any provider
+	if err := validateOutputFormat(opts.Output); err != nil {
+		return err
+	}
+	if opts.Query != "" {
+		if _, err := output.CompileQuery(opts.Query); err != nil {
+			return err
+		}
+	}
+	if _, err := inventorySortKeys(opts); err != nil {
+		return err
+	}
+	if err := validateStreamOptions(opts); err != nil {
+		return err
+	}
+
 	if opts.Verbose {
 		logger.SetLevel(logrus.DebugLevel)
 		logger.Debugf("Using filters: %+v", filters)
 	}
 
+	// JSON Lines output is written as resources are discovered
+	if format, _ := parseOutputFormat(opts.Output); format == "jsonl" {
+		return streamInventory(ctx, cfg, opts, filters, logger)
+	}
+
+	// Keep stdout clean for every format but the table, so it can be piped
+	format, _ := parseOutputFormat(opts.Output)
+	table := format == "" || format == "table"
+	status := io.Writer(os.Stdout)
+	if !table {
+		status = os.Stderr
+	}
+
+	allResources, ok := collectResources(ctx, cfg, opts.Providers, filters, opts.Refresh, status, logger)
+	if !ok {
+		return nil
+	}
+
+	fmt.Fprintf(status, "\n")
+
+	if len(allResources) == 0 {
+		fmt.Fprintf(status, "🔍 No resources found matching the specified criteria.\n\n")
+		fmt.Fprintf(status, "💡 TIPS:\n")
+		fmt.Fprintf(status, "   • Check if you have resources in the specified regions: %v\n", filters.Regions)
+		if len(filters.ResourceTypes) > 0 {
+			fmt.Fprintf(status, "   • Try removing the --type filter to see all resource types\n")
+		}
+		if len(filters.Tags) > 0 || len(filters.TagFilters) > 0 {
+			fmt.Fprintf(status, "   • Try removing the --tag filters to see all resources\n")
+		}
+		if filters.Expression != nil {
+			fmt.Fprintf(status, "   • Check the --filter expression: %s\n", filters.Expression)
+		}
+		fmt.Fprintf(status, "   • Run without filters to see all resources: cloudview inventory\n")
+		fmt.Fprintf(status, "   • Use --verbose for detailed logging\n")
+
+		// Machine-readable formats still get an empty document
+		if table {
+			return nil
+		}
+	} else {
+		fmt.Fprintf(status, "📊 Found %d total resources\n\n", len(allResources))
+	}
+
+	return outputInventoryResults(allResources, opts, logger)
+}
+
+// collectResources queries the requested providers and returns their
+// resources, reporting progress to status. It returns false when no
+// provider can be queried, after telling the user why.
+func collectResources(ctx context.Context, cfg *config.Config, requested []string, filters types.ResourceFilters, refresh bool, status io.Writer, logger *logrus.Logger) ([]models.Resource, bool) {
+	queried, ok := createProviders(ctx, cfg, requested, refresh, status, logger)
+	if !ok {
+		return nil, false
+	}
+
+	// Collect resources from all requested providers
+	var allResources []models.Resource
+
+	for _, provider := range queried {
+		providerName := provider.Name()
+
+		// Get resources from provider
+		fmt.Fprintf(status, "🔍 Querying %s resources...\n", providerName)
+		resources, err := provider.GetResources(ctx, filters)
+		if err != nil {
+			logger.Errorf("Failed to get resources from provider %s: %v", providerName, err)
+			fmt.Fprintf(status, "❌ Failed to get resources from %s: %v\n", providerName, err)
+			continue
+		}
+
+		allResources = append(allResources, resources...)
+		logger.Debugf("Retrieved %d resources from provider %s", len(resources), providerName)
+
+		if len(resources) > 0 {
+			fmt.Fprintf(status, "✅ Found %d resources from %s\n", len(resources), providerName)
+		} else {
+			fmt.Fprintf(status, "ℹ️  No resources found in %s matching the specified criteria\n", providerName)
+		}
+	}
+
+	return allResources, true
+}
+
+// streamInventory writes resources as JSON Lines as each provider service
+// finishes a region. Progress goes to stderr so stdout can be piped.
+func streamInventory(ctx context.Context, cfg *config.Config, opts *InventoryOptions, filters types.ResourceFilters, logger *logrus.Logger) error {
+	queried, ok := createProviders(ctx, cfg, opts.Providers, opts.Refresh, os.Stderr, logger)
+	if !ok {
+		return nil
+	}
+
+	w := io.Writer(os.Stdout)
+	if opts.OutputFile != "" {
+		file, err := os.Create(opts.OutputFile)
+		if err != nil {
+			return fmt.Errorf("failed to create output file: %w", err)
+		}
+		defer file.Close()
+		w = file
+	}
+
+	// Stop discovery if the output can't be written, e.g. a closed pipe
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	writer := output.NewJSONLWriter(w)
+	for _, provider := range queried {
+		providerName := provider.Name()
+
+		fmt.Fprintf(os.Stderr, "🔍 Streaming %s resources...\n", providerName)
+		events, err := providers.StreamResources(ctx, provider, filters)
+		if err != nil {
+			logger.Errorf("Failed to get resources from provider %s: %v", providerName, err)
+			fmt.Fprintf(os.Stderr, "❌ Failed to get resources from %s: %v\n", providerName, err)
+			continue
+		}
+
+		for event := range events {
+			switch event.Type {
+			case types.DiscoveryEventResource:
+				if err := writer.Write(*event.Resource); err != nil {
+					cancel()
+					for range events {
+					}
+					return err
+				}
+			case types.DiscoveryEventServiceDone:
+				if event.Err != nil {
+					logger.Warn(event.Err)
+					fmt.Fprintf(os.Stderr, "⚠️  %s: %v\n", providerName, event.Err)
+					continue
+				}
+				logger.Debugf("Finished %s %s: %d resources", providerName, event.Service, event.Count)
+			}
+		}
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if opts.OutputFile != "" {
+		fmt.Fprintf(os.Stderr, "✅ Wrote %d resources to %s\n", writer.Count(), opts.OutputFile)
+	} else {
+		fmt.Fprintf(os.Stderr, "✅ Streamed %d resources\n", writer.Count())
+	}
+	return nil
+}
+
+// createProviders creates the requested providers that are enabled, wrapped
+// in the result cache. It returns false when no provider can be queried,
+// after telling the user why on status.
+func createProviders(ctx context.Context, cfg *config.Config, requested []string, refresh bool, status io.Writer, logger *logrus.Logger) ([]providers.CloudProvider, bool) {
 	// Validate that at least one provider is enabled and requested
 	enabledProviders := cfg.GetEnabledProviders()
 	if len(enabledProviders) == 0 {
-		fmt.Printf("⚠️  No cloud providers are enabled in configuration.\n")
-		fmt.Printf("💡 Run 'cloudview config init' to create a configuration file,\n")
-		fmt.Printf("   or set AWS_PROFILE environment variable to use AWS.\n")
-		return nil
+		fmt.Fprintf(status, "⚠️  No cloud providers are enabled in configuration.\n")
+		fmt.Fprintf(status, "💡 Run 'cloudview config init' to create a configuration file,\n")
+		fmt.Fprintf(status, "   or set AWS_PROFILE environment variable to use AWS.\n")
+		return nil, false
 	}
 
 	// Filter requested providers to only enabled ones
 	var validProviders []string
-	for _, requestedProvider := range opts.Providers {
+	for _, requestedProvider := range requested {
 		if requestedProvider == "all" {
 			// Add all enabled providers
 			for name := range enabledProviders {
@@ -150,10 +405,10 @@ func runInventoryCommand(ctx context.Context, opts *InventoryOptions, logger *lo
 	}
 
 	if len(validProviders) == 0 {
-		fmt.Printf("⚠️  None of the requested providers are enabled: %v\n", opts.Providers)
-		fmt.Printf("💡 Enabled providers: %v\n", getEnabledProviderNames(enabledProviders))
-		fmt.Printf("   Use --provider with one of the enabled providers.\n")
-		return nil
+		fmt.Fprintf(status, "⚠️  None of the requested providers are enabled: %v\n", requested)
+		fmt.Fprintf(status, "💡 Enabled providers: %v\n", getEnabledProviderNames(enabledProviders))
+		fmt.Fprintf(status, "   Use --provider with one of the enabled providers.\n")
+		return nil, false
 	}
 
 	logger.Debugf("Querying providers: %v", validProviders)
@@ -161,11 +416,12 @@ func runInventoryCommand(ctx context.Context, opts *InventoryOptions, logger *lo
 	// Create provider factory
 	factory := providers.NewProviderFactory(providers.DefaultRegistry, logger)
 
-	// Collect resources from all requested providers
-	var allResources []models.Resource
+	// Create result cache
+	store := newCacheStore(cfg, logger)
 
+	var created []providers.CloudProvider
 	for _, providerName := range validProviders {
-		logger.Debugf("Querying provider: %s", providerName)
+		logger.Debugf("Creating provider: %s", providerName)
 
 		// Get provider configuration
 		providerConfig := enabledProviders[providerName]
@@ -174,49 +430,36 @@ func runInventoryCommand(ctx context.Context, opts *InventoryOptions, logger *lo
 		provider, err := factory.CreateProvider(ctx, providerName, providerConfig)
 		if err != nil {
 			logger.Errorf("Failed to create provider %s: %v", providerName, err)
-			fmt.Printf("❌ Failed to initialize %s provider: %v\n", providerName, err)
+			fmt.Fprintf(status, "❌ Failed to initialize %s provider: %v\n", providerName, err)
 			continue
 		}
 
-		// Get resources from provider
-		fmt.Printf("🔍 Querying %s resources...\n", providerName)
-		resources, err := provider.GetResources(ctx, filters)
-		if err != nil {
-			logger.Errorf("Failed to get resources from provider %s: %v", providerName, err)
-			fmt.Printf("❌ Failed to get resources from %s: %v\n", providerName, err)
-			continue
+		// Serve repeated queries from the cache
+		if store != nil {
+			cachedProvider := providers.NewCachedProvider(provider, store, cfg.Cache.TTL, providerConfig.GetRegions(), logger)
+			cachedProvider.SetRefresh(refresh)
+			provider = cachedProvider
 		}
 
-		allResources = append(allResources, resources...)
-		logger.Debugf("Retrieved %d resources from provider %s", len(resources), providerName)
-
-		if len(resources) > 0 {
-			fmt.Printf("✅ Found %d resources from %s\n", len(resources), providerName)
-		} else {
-			fmt.Printf("ℹ️  No resources found in %s matching the specified criteria\n", providerName)
-		}
+		created = append(created, provider)
 	}
 
-	fmt.Printf("\n")
+	return created, true
+}
 
-	if len(allResources) == 0 {
-		fmt.Printf("🔍 No resources found matching the specified criteria.\n\n")
-		fmt.Printf("💡 TIPS:\n")
-		fmt.Printf("   • Check if you have resources in the specified regions: %v\n", filters.Regions)
-		if len(filters.ResourceTypes) > 0 {
-			fmt.Printf("   • Try removing the --type filter to see all resource types\n")
-		}
-		if len(filters.Tags) > 0 {
-			fmt.Printf("   • Try removing the --tag filters to see all resources\n")
-		}
-		fmt.Printf("   • Run without filters to see all resources: cloudview inventory\n")
-		fmt.Printf("   • Use --verbose for detailed logging\n")
+// newCacheStore creates the result cache store, or returns nil if caching is disabled
+func newCacheStore(cfg *config.Config, logger *logrus.Logger) cache.Store {
+	if !cfg.Cache.Enabled {
 		return nil
 	}
 
-	// Output results
-	fmt.Printf("📊 Found %d total resources\n\n", len(allResources))
-	return outputInventoryResults(allResources, opts, logger)
+	store, err := cache.NewStore(cfg.Cache)
+	if err != nil {
+		logger.Warnf("Failed to initialize cache, continuing without it: %v", err)
+		return nil
+	}
+
+	return store
 }
 
 // getEnabledProviderNames returns a slice of enabled provider names
@@ -237,13 +480,24 @@ func parseInventoryFilters(opts *InventoryOptions) (types.ResourceFilters, error
 		Tags:          make(map[string]string),
 	}
 
-	// Parse tags
+	for _, resourceType := range opts.ResourceTypes {
+		if _, ok := models.ResolveResourceType(resourceType); !ok {
+			return filters, fmt.Errorf("unknown resource type: %s", resourceType)
+		}
+	}
+
+	// Parse tags. Exact key=value pairs stay in the Tags map; existence,
+	// absence, alternatives and patterns become tag filters.
 	for _, tagStr := range opts.Tags {
-		parts := strings.SplitN(tagStr, "=", 2)
-		if len(parts) != 2 {
-			return filters, fmt.Errorf("invalid tag format: %s (expected key=value)", tagStr)
+		tagFilter, err := types.ParseTagFilter(tagStr)
+		if err != nil {
+			return filters, err
+		}
+		if tagFilter.IsExact() {
+			filters.Tags[tagFilter.Key] = tagFilter.Values[0]
+			continue
 		}
-		filters.Tags[parts[0]] = parts[1]
+		filters.TagFilters = append(filters.TagFilters, tagFilter)
 	}
 
 	// Parse time filters
@@ -263,23 +517,211 @@ func parseInventoryFilters(opts *InventoryOptions) (types.ResourceFilters, error
 		filters.CreatedBefore = &t
 	}
 
+	// Parse filter expression
+	if opts.Filter != "" {
+		expression, err := types.ParseFilterExpression(opts.Filter)
+		if err != nil {
+			return filters, err
+		}
+		filters.Expression = expression
+	}
+
 	return filters, nil
 }
 
 // outputInventoryResults outputs the inventory results in the specified format
 func outputInventoryResults(resources []models.Resource, opts *InventoryOptions, logger *logrus.Logger) error {
-	switch strings.ToLower(opts.Output) {
+	format, arg := parseOutputFormat(opts.Output)
+
+	// Always sort so output is stable between runs
+	sortKeys, err := inventorySortKeys(opts)
+	if err != nil {
+		return err
+	}
+	output.SortResources(resources, sortKeys)
+
+	// A query reshapes the output document. Resource renderers can still be
+	// used as long as the result is a list of resources.
+	var document interface{} = inventoryDocument(resources)
+	queryResources := true
+	if opts.Query != "" {
+		result, err := output.ApplyQuery(document, opts.Query)
+		if err != nil {
+			return err
+		}
+		document = result
+		resources, queryResources = output.ResourcesFrom(result)
+
+		switch format {
+		case "", "table", "json", "yaml", "go-template", "go-template-file":
+		default:
+			if !queryResources {
+				return fmt.Errorf("%s output requires --query to return a list of resources", format)
+			}
+		}
+	}
+
+	// Spreadsheets are binary, so they always go to a file
+	if format == "excel" || format == "xlsx" {
+		return outputInventoryExcel(resources, opts)
+	}
+
+	w := io.Writer(os.Stdout)
+	if opts.OutputFile != "" {
+		file, err := os.Create(opts.OutputFile)
+		if err != nil {
+			return fmt.Errorf("failed to create output file: %w", err)
+		}
+		defer file.Close()
+		w = file
+	}
+
+	switch format {
 	case "json":
-		return outputInventoryJSON(resources, opts)
+		err = NewJSONEncoder(w).Encode(document)
 	case "yaml":
-		return outputInventoryYAML(resources, opts)
+		err = NewYAMLEncoder(w).Encode(document)
+	case "csv":
+		err = output.WriteCSV(w, resources, !opts.NoHeader)
+	case "tsv":
+		err = output.WriteTSV(w, resources, !opts.NoHeader)
+	case "html":
+		err = output.WriteHTML(w, resources)
+	case "markdown", "md":
+		err = output.WriteMarkdown(w, resources, output.MarkdownOptions{Details: opts.Details})
+	case "go-template", "go-template-file":
+		err = outputInventoryTemplate(w, document, format, arg)
+	case "custom-columns":
+		err = outputInventoryCustomColumns(w, resources, arg, opts)
 	case "table":
 		fallthrough
 	default:
-		return outputInventoryTable(resources, opts)
+		if queryResources {
+			err = outputInventoryTable(w, resources, opts)
+		} else {
+			err = output.WriteValueTable(w, document, !opts.NoHeader)
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	if opts.OutputFile != "" {
+		fmt.Fprintf(os.Stderr, "✅ Wrote %s output to %s\n", format, opts.OutputFile)
+	}
+	return nil
+}
+
+// inventorySortKeys returns the sort order for inventory output. Grouping
+// sorts by the group field first so groups are contiguous in every format.
+func inventorySortKeys(opts *InventoryOptions) ([]output.SortKey, error) {
+	specs := opts.SortBy
+	if opts.GroupBy != "" {
+		specs = append([]string{opts.GroupBy}, specs...)
+	}
+
+	keys, err := output.ParseSortKeys(specs)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --sort-by/--group-by: %w", err)
+	}
+	return keys, nil
+}
+
+// parseOutputFormat splits an output flag such as "custom-columns=ID:.id"
+// into the lower-cased format name and its argument
+func parseOutputFormat(value string) (string, string) {
+	format, arg, _ := strings.Cut(value, "=")
+	return strings.ToLower(strings.TrimSpace(format)), arg
+}
+
+// validateOutputFormat checks that an output flag names a known format and
+// that templates and column specifications parse
+func validateOutputFormat(value string) error {
+	format, arg := parseOutputFormat(value)
+
+	switch format {
+	case "", "table", "json", "jsonl", "yaml", "csv", "tsv", "html", "markdown", "md", "excel", "xlsx":
+		return nil
+	case "go-template":
+		_, err := output.ParseTemplate(arg)
+		return err
+	case "go-template-file":
+		_, err := output.ParseTemplateFile(arg)
+		return err
+	case "custom-columns":
+		_, err := output.ParseCustomColumns(arg)
+		return err
+	default:
+		return fmt.Errorf("unsupported output format: %s (supported: table, json, jsonl, yaml, csv, tsv, html, markdown, excel, go-template, go-template-file, custom-columns)", format)
 	}
 }
 
+// validateStreamOptions rejects options that need every resource at once,
+// which can't be combined with streamed JSON Lines output
+func validateStreamOptions(opts *InventoryOptions) error {
+	if format, _ := parseOutputFormat(opts.Output); format != "jsonl" {
+		return nil
+	}
+
+	switch {
+	case opts.Query != "":
+		return fmt.Errorf("--query can't be used with jsonl output; pipe the lines to a tool such as jq instead")
+	case len(opts.SortBy) > 0 || opts.GroupBy != "":
+		return fmt.Errorf("--sort-by and --group-by can't be used with jsonl output, which is written in discovery order")
+	}
+	return nil
+}
+
+// outputInventoryTemplate renders the output document with a Go template
+func outputInventoryTemplate(w io.Writer, document interface{}, format, arg string) error {
+	var tmpl *template.Template
+	var err error
+	if format == "go-template-file" {
+		tmpl, err = output.ParseTemplateFile(arg)
+	} else {
+		tmpl, err = output.ParseTemplate(arg)
+	}
+	if err != nil {
+		return err
+	}
+
+	return output.WriteTemplate(w, tmpl, document)
+}
+
+// outputInventoryCustomColumns outputs resources as a table of user-defined columns
+func outputInventoryCustomColumns(w io.Writer, resources []models.Resource, spec string, opts *InventoryOptions) error {
+	columns, err := output.ParseCustomColumns(spec)
+	if err != nil {
+		return err
+	}
+
+	return output.WriteCustomColumns(w, resources, columns, !opts.NoHeader)
+}
+
+// outputInventoryExcel writes resources to an xlsx workbook
+func outputInventoryExcel(resources []models.Resource, opts *InventoryOptions) error {
+	path := opts.OutputFile
+	if path == "" {
+		path = fmt.Sprintf("cloudview-inventory-%s.xlsx", time.Now().Format("20060102-150405"))
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create output file: %w", err)
+	}
+
+	if err := output.WriteExcel(file, resources); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to write Excel workbook: %w", err)
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("failed to write Excel workbook: %w", err)
+	}
+
+	fmt.Fprintf(os.Stderr, "✅ Wrote %d resources to %s\n", len(resources), path)
+	return nil
+}
+
 // TableColumnWidths holds the calculated column widths
 type TableColumnWidths struct {
 	ID       int
@@ -391,9 +833,16 @@ func formatTagsForDisplay(tags map[string]string, noTruncate bool) string {
 		return ""
 	}
 
-	var tagPairs []string
-	for key, value := range tags {
-		tagPairs = append(tagPairs, fmt.Sprintf("%s=%s", key, value))
+	// Sort by key so the column and what survives truncation are stable
+	keys := make([]string, 0, len(tags))
+	for key := range tags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	tagPairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		tagPairs = append(tagPairs, fmt.Sprintf("%s=%s", key, tags[key]))
 	}
 
 	result := strings.Join(tagPairs, ", ")
@@ -411,15 +860,50 @@ func formatTagsForDisplay(tags map[string]string, noTruncate bool) string {
 }
 
 // outputInventoryTable outputs resources in an improved table format
-func outputInventoryTable(resources []models.Resource, opts *InventoryOptions) error {
+func outputInventoryTable(w io.Writer, resources []models.Resource, opts *InventoryOptions) error {
 	if len(resources) == 0 {
-		fmt.Println("No resources found.")
+		fmt.Fprintln(w, "No resources found.")
 		return nil
 	}
 
-	// Calculate column widths
+	// Calculate column widths across all resources so grouped sections line up
 	widths := calculateColumnWidths(resources, opts)
 
+	if opts.GroupBy != "" {
+		groups := output.GroupResources(resources, opts.GroupBy)
+		for i, group := range groups {
+			if i > 0 {
+				fmt.Fprintf(w, "\n")
+			}
+			fmt.Fprintf(w, "📁 %s: %s (%d resources)\n\n", opts.GroupBy, group.Key, len(group.Resources))
+			writeInventoryTableRows(w, group.Resources, widths, opts)
+		}
+
+		// Print per-group subtotals
+		fmt.Fprintf(w, "\n📊 Resources by %s:\n", opts.GroupBy)
+		for _, group := range groups {
+			fmt.Fprintf(w, "   %-30s %d\n", group.Key, len(group.Resources))
+		}
+		fmt.Fprintf(w, "\nTotal resources: %d in %d groups\n", len(resources), len(groups))
+	} else {
+		writeInventoryTableRows(w, resources, widths, opts)
+
+		// Print summary
+		fmt.Fprintf(w, "\nTotal resources: %d\n", len(resources))
+	}
+
+	// Print helpful tips if using default formatting
+	if !opts.NoTruncate && !opts.Wide {
+		fmt.Fprintf(w, "\n💡 Tip: Use --wide or --no-truncate for better readability\n")
+		fmt.Fprintf(w, "   --wide: Wider columns with more spacing\n")
+		fmt.Fprintf(w, "   --no-truncate: Show full names without truncation\n")
+	}
+
+	return nil
+}
+
+// writeInventoryTableRows writes the table header and one row per resource
+func writeInventoryTableRows(w io.Writer, resources []models.Resource, widths TableColumnWidths, opts *InventoryOptions) {
 	// Create format strings for proper alignment
 	headerFormat := fmt.Sprintf("%%-%ds  %%-%ds  %%-%ds  %%-%ds  %%-%ds  %%-%ds  %%s\n",
 		widths.ID, widths.Name, widths.Type, widths.Provider, widths.Region, widths.Status)
@@ -429,7 +913,7 @@ func outputInventoryTable(resources []models.Resource, opts *InventoryOptions) e
 
 	// Print header
 	if !opts.NoHeader {
-		fmt.Printf(headerFormat, "ID", "NAME", "TYPE", "PROVIDER", "REGION", "STATUS", "TAGS")
+		fmt.Fprintf(w, headerFormat, "ID", "NAME", "TYPE", "PROVIDER", "REGION", "STATUS", "TAGS")
 
 		// Print separator line
 		separator := strings.Repeat("-", widths.ID) + "  " +
@@ -439,7 +923,7 @@ func outputInventoryTable(resources []models.Resource, opts *InventoryOptions) e
 			strings.Repeat("-", widths.Region) + "  " +
 			strings.Repeat("-", widths.Status) + "  " +
 			strings.Repeat("-", widths.Tags)
-		fmt.Println(separator)
+		fmt.Fprintln(w, separator)
 	}
 
 	// Print resources
@@ -453,20 +937,8 @@ func outputInventoryTable(resources []models.Resource, opts *InventoryOptions) e
 		status := prepareDisplayValue(resource.Status.State, widths.Status, opts.NoTruncate)
 		tags := formatTagsForDisplay(resource.Tags, opts.NoTruncate)
 
-		fmt.Printf(rowFormat, id, name, resourceType, provider, region, status, tags)
+		fmt.Fprintf(w, rowFormat, id, name, resourceType, provider, region, status, tags)
 	}
-
-	// Print summary
-	fmt.Printf("\nTotal resources: %d\n", len(resources))
-
-	// Print helpful tips if using default formatting
-	if !opts.NoTruncate && !opts.Wide {
-		fmt.Printf("\n💡 Tip: Use --wide or --no-truncate for better readability\n")
-		fmt.Printf("   --wide: Wider columns with more spacing\n")
-		fmt.Printf("   --no-truncate: Show full names without truncation\n")
-	}
-
-	return nil
 }
 
 // prepareDisplayValue prepares a value for display, applying truncation if needed
@@ -477,28 +949,17 @@ func prepareDisplayValue(value string, maxWidth int, noTruncate bool) string {
 	return truncateString(value, maxWidth)
 }
 
-// outputInventoryJSON outputs resources in JSON format
-func outputInventoryJSON(resources []models.Resource, opts *InventoryOptions) error {
-	output := map[string]interface{}{
-		"resources": resources,
-		"total":     len(resources),
-		"timestamp": time.Now().UTC().Format(time.RFC3339),
+// inventoryDocument builds the document rendered by the json, yaml and
+// template outputs and searched by --query
+func inventoryDocument(resources []models.Resource) map[string]interface{} {
+	if resources == nil {
+		resources = []models.Resource{}
 	}
-
-	encoder := NewJSONEncoder(os.Stdout)
-	return encoder.Encode(output)
-}
-
-// outputInventoryYAML outputs resources in YAML format
-func outputInventoryYAML(resources []models.Resource, opts *InventoryOptions) error {
-	output := map[string]interface{}{
+	return map[string]interface{}{
 		"resources": resources,
 		"total":     len(resources),
 		"timestamp": time.Now().UTC().Format(time.RFC3339),
 	}
-
-	encoder := NewYAMLEncoder(os.Stdout)
-	return encoder.Encode(output)
 }
 
 // truncateString truncates a string to the specified length
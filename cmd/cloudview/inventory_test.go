@@ -1,10 +1,14 @@
 package cloudview
 
 import (
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/Tsahi-Elkayam/cloudview/pkg/types"
+	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -62,10 +66,42 @@ func TestParseInventoryFilters(t *testing.T) {
 			}(),
 			wantErr: false,
 		},
+		{
+			name: "with tag existence, absence, alternatives and patterns",
+			opts: &InventoryOptions{
+				Tags: []string{"Owner", "!CostCenter", "Environment=prod|staging", "Name=web-*", "Team=backend"},
+			},
+			want: types.ResourceFilters{
+				Tags: map[string]string{
+					"Team": "backend",
+				},
+				TagFilters: []types.TagFilter{
+					{Key: "Owner"},
+					{Key: "CostCenter", Absent: true},
+					{Key: "Environment", Values: []string{"prod", "staging"}},
+					{Key: "Name", Values: []string{"web-*"}},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "unknown resource type",
+			opts: &InventoryOptions{
+				ResourceTypes: []string{"ec2", "teleporter"},
+			},
+			wantErr: true,
+		},
 		{
 			name: "invalid tag format",
 			opts: &InventoryOptions{
-				Tags: []string{"invalid-tag-format"},
+				Tags: []string{"=value"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid tag regex",
+			opts: &InventoryOptions{
+				Tags: []string{"Name=~web-("},
 			},
 			wantErr: true,
 		},
@@ -92,6 +128,7 @@ func TestParseInventoryFilters(t *testing.T) {
 			assert.Equal(t, tt.want.ResourceTypes, got.ResourceTypes)
 			assert.Equal(t, tt.want.Status, got.Status)
 			assert.Equal(t, tt.want.Tags, got.Tags)
+			assert.Equal(t, tt.want.TagFilters, got.TagFilters)
 			
 			if tt.want.CreatedAfter != nil {
 				require.NotNil(t, got.CreatedAfter)
@@ -153,10 +190,47 @@ func TestTruncateString(t *testing.T) {
 	}
 }
 
+func TestFormatTagsForDisplay(t *testing.T) {
+	tags := map[string]string{"Team": "backend", "Environment": "production", "Owner": "platform-team"}
+
+	assert.Equal(t, "Environment=production, Owner=platform-team, Team=backend", formatTagsForDisplay(tags, true))
+	for i := 0; i < 10; i++ {
+		assert.Equal(t, "Environment=production, ...", formatTagsForDisplay(tags, false))
+	}
+	assert.Equal(t, "", formatTagsForDisplay(nil, false))
+}
+
+func TestInventoryTagFlagKeepsCommas(t *testing.T) {
+	cmd := NewInventoryCommand(logrus.New())
+	require.NoError(t, cmd.ParseFlags([]string{"--tag", "Name=~^(web|api),prod", "--tag", "Team"}))
+
+	tags, err := cmd.Flags().GetStringArray("tag")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Name=~^(web|api),prod", "Team"}, tags)
+}
+
+func TestOutputInventoryResultsEmpty(t *testing.T) {
+	dir := t.TempDir()
+
+	jsonFile := filepath.Join(dir, "inventory.json")
+	require.NoError(t, outputInventoryResults(nil, &InventoryOptions{Output: "json", OutputFile: jsonFile}, logrus.New()))
+	data, err := os.ReadFile(jsonFile)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"resources": []`)
+
+	csvFile := filepath.Join(dir, "inventory.csv")
+	require.NoError(t, outputInventoryResults(nil, &InventoryOptions{Output: "csv", OutputFile: csvFile}, logrus.New()))
+	data, err = os.ReadFile(csvFile)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	assert.Len(t, lines, 1)
+	assert.True(t, strings.HasPrefix(lines[0], "id,"), lines[0])
+}
+
 // Integration-style test to verify the inventory command structure
 func TestInventoryCommandCreation(t *testing.T) {
 	// This test verifies the command can be created without errors
-	logger := &MockLogger{}
+	logger := logrus.New()
 	cmd := NewInventoryCommand(logger)
 	
 	assert.NotNil(t, cmd)
@@ -35,7 +35,7 @@ a unified interface for managing and monitoring resources across multiple cloud
 🔧  Environment variables can override any configuration setting
 
 Currently supported providers:
-  ✅ AWS (EC2, S3, RDS, IAM, VPC, Security Groups)
+  ✅ AWS (EC2, S3, RDS, IAM, VPC, Security Groups, EBS, ELB, Lambda, ECS, DynamoDB)
   🚧 GCP (planned)  
   🚧 Azure (planned)
 
@@ -97,7 +97,12 @@ Configuration priority (highest to lowest):
 
 	// Add subcommands
 	rootCmd.AddCommand(NewInventoryCommand(logger))
+	rootCmd.AddCommand(NewSearchCommand(logger))
+	rootCmd.AddCommand(NewDescribeCommand(logger))
+	rootCmd.AddCommand(NewStatusCommand(logger))
+	rootCmd.AddCommand(NewViewCommand(logger))
 	rootCmd.AddCommand(NewConfigCommand(logger))
+	rootCmd.AddCommand(NewCacheCommand(logger))
 
 	return rootCmd
 }
@@ -117,11 +122,14 @@ Welcome to CloudView! 🚀
    cloudview inventory --provider aws     # Show AWS resources
    cloudview inventory --type ec2         # Show EC2 instances
    cloudview inventory --help             # More inventory options
+   cloudview describe <id>                # Details of one resource
+   cloudview status <id> --until running  # Wait for a resource state
 
 ⚙️  CONFIGURATION:
    cloudview config show                  # View current config
    cloudview config init                  # Create config file
    cloudview config path                  # Show config locations
+   cloudview cache stats                  # Show cache usage
 
 📖 EXAMPLES:
    # List resources with filters
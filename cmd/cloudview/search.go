@@ -0,0 +1,182 @@
+package cloudview
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/Tsahi-Elkayam/cloudview/pkg/output"
+)
+
+// SearchOptions holds options for the search command
+type SearchOptions struct {
+	Providers     []string
+	Regions       []string
+	ResourceTypes []string
+	Tags          []string
+	Exact         bool
+	Output        string
+	Query         string
+	NoHeader      bool
+	Verbose       bool
+	Refresh       bool
+}
+
+// NewSearchCommand creates the search command
+func NewSearchCommand(logger *logrus.Logger) *cobra.Command {
+	opts := &SearchOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "search <term>",
+		Short: "Find resources by ID, name, tag or metadata value",
+		Long: `Search the inventory for resources whose ID, name, ARN, tag keys or values,
+or any metadata value (IP addresses, endpoints, CIDR blocks, key names, ...)
+contain the term. Matching is case-insensitive and every matching field is shown.
+
+Examples:
+  # Which resource owns this IP address?
+  cloudview search 10.0.1.25 --exact
+
+  # Find an RDS instance by its endpoint hostname
+  cloudview search mydb.abc123.us-east-1.rds.amazonaws.com
+
+  # Search security groups only
+  cloudview search 203.0.113.0/24 --type security_group
+
+  # Machine-readable results
+  cloudview search web-server --output json
+
+  # Just the IDs of the matching resources
+  cloudview search web-server -o json --query "matches[].resource.id"`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSearchCommand(cmd.Context(), args[0], opts, logger)
+		},
+	}
+
+	cmd.Flags().StringSliceVarP(&opts.Providers, "provider", "p", []string{"all"},
+		"Cloud providers to query (aws, all)")
+	cmd.Flags().StringSliceVarP(&opts.Regions, "region", "r", []string{},
+		"Regions to query (comma-separated)")
+	cmd.Flags().StringSliceVarP(&opts.ResourceTypes, "type", "t", []string{},
+		"Resource types to search (ec2,rds,security_group,etc)")
+	cmd.Flags().StringArrayVar(&opts.Tags, "tag", []string{},
+		"Tags to filter by before searching (same syntax as inventory --tag)")
+	cmd.Flags().BoolVar(&opts.Exact, "exact", false,
+		"Match whole values (or whole list items) instead of substrings")
+	cmd.Flags().StringVarP(&opts.Output, "output", "o", "table",
+		"Output format (table, json, yaml)")
+	cmd.Flags().StringVar(&opts.Query, "query", "",
+		"JMESPath query applied to the output document (e.g. \"matches[].resource.id\")")
+	cmd.Flags().BoolVar(&opts.NoHeader, "no-header", false,
+		"Don't print column headers")
+	cmd.Flags().BoolVarP(&opts.Verbose, "verbose", "v", false,
+		"Verbose output")
+	cmd.Flags().BoolVar(&opts.Refresh, "refresh", false,
+		"Bypass cached results and query the cloud provider APIs")
+
+	return cmd
+}
+
+// runSearchCommand executes the search command
+func runSearchCommand(ctx context.Context, term string, opts *SearchOptions, logger *logrus.Logger) error {
+	cfg := GetGlobalConfig()
+	if cfg == nil {
+		return fmt.Errorf("configuration not loaded")
+	}
+
+	if strings.TrimSpace(term) == "" {
+		return fmt.Errorf("search term cannot be empty")
+	}
+
+	format := strings.ToLower(opts.Output)
+	switch format {
+	case "table", "json", "yaml":
+	default:
+		return fmt.Errorf("unsupported output format: %s (supported: table, json, yaml)", opts.Output)
+	}
+	if opts.Query != "" {
+		if _, err := output.CompileQuery(opts.Query); err != nil {
+			return err
+		}
+	}
+
+	filters, err := parseInventoryFilters(&InventoryOptions{
+		Regions:       opts.Regions,
+		ResourceTypes: opts.ResourceTypes,
+		Tags:          opts.Tags,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to parse filters: %w", err)
+	}
+
+	if opts.Verbose {
+		logger.SetLevel(logrus.DebugLevel)
+		logger.Debugf("Using filters: %+v", filters)
+	}
+
+	// Keep stdout clean for machine-readable output
+	status := io.Writer(os.Stdout)
+	if format != "table" {
+		status = os.Stderr
+	}
+
+	resources, ok := collectResources(ctx, cfg, opts.Providers, filters, opts.Refresh, status, logger)
+	if !ok {
+		return nil
+	}
+
+	output.SortResources(resources, output.DefaultSortKeys)
+	matches := output.SearchResources(resources, term, output.SearchOptions{Exact: opts.Exact})
+
+	// A query reshapes the output document, so the match table no longer applies
+	if opts.Query != "" {
+		result, err := output.ApplyQuery(searchDocument(term, matches), opts.Query)
+		if err != nil {
+			return err
+		}
+		switch format {
+		case "json":
+			return NewJSONEncoder(os.Stdout).Encode(result)
+		case "yaml":
+			return NewYAMLEncoder(os.Stdout).Encode(result)
+		}
+		return output.WriteValueTable(os.Stdout, result, !opts.NoHeader)
+	}
+
+	switch format {
+	case "json":
+		return NewJSONEncoder(os.Stdout).Encode(searchDocument(term, matches))
+	case "yaml":
+		return NewYAMLEncoder(os.Stdout).Encode(searchDocument(term, matches))
+	}
+
+	fmt.Printf("\n")
+	if len(matches) == 0 {
+		fmt.Printf("🔍 No resources match %q among %d resources.\n", term, len(resources))
+		if opts.Exact {
+			fmt.Printf("💡 Try again without --exact to match partial values.\n")
+		}
+		return nil
+	}
+
+	fmt.Printf("🔎 %d of %d resources match %q\n\n", len(matches), len(resources), term)
+	return output.WriteSearchTable(os.Stdout, matches, !opts.NoHeader)
+}
+
+// searchDocument builds the JSON/YAML document for search results
+func searchDocument(term string, matches []output.SearchMatch) map[string]interface{} {
+	if matches == nil {
+		matches = []output.SearchMatch{}
+	}
+	return map[string]interface{}{
+		"term":    term,
+		"matches": matches,
+		"total":   len(matches),
+	}
+}
@@ -0,0 +1,303 @@
+package cloudview
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/Tsahi-Elkayam/cloudview/pkg/models"
+	"github.com/Tsahi-Elkayam/cloudview/pkg/providers"
+)
+
+// notFoundState is shown for resources whose status can't be resolved
+const notFoundState = "not found"
+
+// StatusOptions holds options for the status command
+type StatusOptions struct {
+	Providers []string
+	Output    string
+	NoHeader  bool
+	Watch     bool
+	Until     []string
+	Interval  time.Duration
+	Timeout   time.Duration
+	Verbose   bool
+}
+
+// ResourceStatusResult is the live status of one requested resource
+type ResourceStatusResult struct {
+	ID       string                 `json:"id"`
+	Provider string                 `json:"provider,omitempty"`
+	Status   *models.ResourceStatus `json:"status,omitempty"`
+	Error    string                 `json:"error,omitempty"`
+
+	// failure is the first lookup that failed for a reason other than the
+	// resource not existing, such as access denied or throttling
+	failure error
+}
+
+// State returns the resource's state, or "not found" if it couldn't be resolved
+func (r ResourceStatusResult) State() string {
+	if r.Status == nil {
+		return notFoundState
+	}
+	return r.Status.State
+}
+
+// NewStatusCommand creates the status command
+func NewStatusCommand(logger *logrus.Logger) *cobra.Command {
+	opts := &StatusOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "status <id|name|arn>...",
+		Short: "Show the live status of resources, or wait for a state",
+		Long: `Query the live state and health of resources directly from the provider APIs,
+bypassing the inventory cache. Resources are identified by ID, name or ARN:
+EC2 instances, EBS volumes and snapshots, S3 buckets, RDS instances and
+clusters, DynamoDB tables, VPCs, security groups, load balancers, Lambda
+functions, ECS clusters, services and tasks (as cluster/name) and IAM users,
+roles and policies are supported. The health of a load balancer reflects its
+registered targets, and that of an ECS service its running task count.
+
+With --watch the status is polled and every change is printed. With --until
+the command waits until every resource reaches one of the given states and
+fails if --timeout passes first, so deploy scripts can block on it.
+
+Examples:
+  # Current status of an instance and a database
+  cloudview status i-0abc123def4567890 orders-db
+
+  # Wait for a database to finish modifying
+  cloudview status orders-db --until available --timeout 45m
+
+  # Follow state changes until interrupted
+  cloudview status i-0abc123def4567890 --watch --interval 5s`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runStatusCommand(cmd.Context(), args, opts, logger)
+		},
+	}
+
+	cmd.Flags().StringSliceVarP(&opts.Providers, "provider", "p", []string{"all"},
+		"Cloud providers to query (aws, all)")
+	cmd.Flags().StringVarP(&opts.Output, "output", "o", "table",
+		"Output format (table, json, yaml)")
+	cmd.Flags().BoolVar(&opts.NoHeader, "no-header", false,
+		"Don't print column headers")
+	cmd.Flags().BoolVarP(&opts.Watch, "watch", "w", false,
+		"Poll and print status changes")
+	cmd.Flags().StringSliceVar(&opts.Until, "until", []string{},
+		"Wait until every resource reaches one of these states (implies --watch)")
+	cmd.Flags().DurationVar(&opts.Interval, "interval", 10*time.Second,
+		"Polling interval for --watch")
+	cmd.Flags().DurationVar(&opts.Timeout, "timeout", 30*time.Minute,
+		"Give up waiting for --until after this long (0 waits forever)")
+	cmd.Flags().BoolVarP(&opts.Verbose, "verbose", "v", false,
+		"Verbose output")
+
+	return cmd
+}
+
+// runStatusCommand executes the status command
+func runStatusCommand(ctx context.Context, ids []string, opts *StatusOptions, logger *logrus.Logger) error {
+	cfg := GetGlobalConfig()
+	if cfg == nil {
+		return fmt.Errorf("configuration not loaded")
+	}
+
+	watch := opts.Watch || len(opts.Until) > 0
+	format := strings.ToLower(opts.Output)
+	switch format {
+	case "table":
+	case "json", "yaml":
+		if watch {
+			return fmt.Errorf("%s output can't be combined with --watch or --until", format)
+		}
+	default:
+		return fmt.Errorf("unsupported output format: %s (supported: table, json, yaml)", opts.Output)
+	}
+	if watch && opts.Interval < time.Second {
+		return fmt.Errorf("--interval must be at least 1s, got %s", opts.Interval)
+	}
+
+	if opts.Verbose {
+		logger.SetLevel(logrus.DebugLevel)
+	}
+
+	// Keep stdout clean for machine-readable output. Live status is never
+	// served from the cache.
+	status := io.Writer(os.Stdout)
+	if format != "table" {
+		status = os.Stderr
+	}
+	queried, ok := createProviders(ctx, cfg, opts.Providers, false, status, logger)
+	if !ok {
+		return nil
+	}
+
+	if watch {
+		if len(opts.Until) > 0 && opts.Timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+			defer cancel()
+		}
+		return watchStatuses(ctx, queried, ids, opts.Until, opts.Interval, os.Stdout, logger)
+	}
+
+	results := checkStatuses(ctx, queried, ids, logger)
+	switch format {
+	case "json":
+		if err := NewJSONEncoder(os.Stdout).Encode(results); err != nil {
+			return err
+		}
+	case "yaml":
+		if err := NewYAMLEncoder(os.Stdout).Encode(results); err != nil {
+			return err
+		}
+	default:
+		if err := writeStatusTable(os.Stdout, results, !opts.NoHeader); err != nil {
+			return err
+		}
+	}
+
+	var missing int
+	for _, result := range results {
+		if result.Status == nil {
+			missing++
+		}
+	}
+	if missing > 0 {
+		return fmt.Errorf("status of %d of %d resources could not be resolved", missing, len(results))
+	}
+	return nil
+}
+
+// checkStatuses resolves the status of each resource with the first provider
+// that knows it
+func checkStatuses(ctx context.Context, queried []providers.CloudProvider, ids []string, logger *logrus.Logger) []ResourceStatusResult {
+	results := make([]ResourceStatusResult, 0, len(ids))
+	for _, id := range ids {
+		result := ResourceStatusResult{ID: id}
+
+		var errs []string
+		for _, provider := range queried {
+			status, err := provider.GetResourceStatus(ctx, id)
+			if err != nil {
+				logger.Debugf("Failed to get status of %s from %s: %v", id, provider.Name(), err)
+				errs = append(errs, fmt.Sprintf("%s: %v", provider.Name(), err))
+				if !providers.IsNotFound(err) && result.failure == nil {
+					result.failure = fmt.Errorf("%s: %w", provider.Name(), err)
+				}
+				continue
+			}
+			result.Provider = provider.Name()
+			result.Status = status
+			break
+		}
+
+		if result.Status == nil {
+			result.Error = strings.Join(errs, "; ")
+			if result.Error == "" {
+				result.Error = "no provider to query"
+			}
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// watchStatuses polls resource statuses, printing each change. With targets
+// it returns once every resource is in one of the target states, or an
+// error when ctx expires first; otherwise it polls until ctx is done.
+func watchStatuses(ctx context.Context, queried []providers.CloudProvider, ids, targets []string, interval time.Duration, w io.Writer, logger *logrus.Logger) error {
+	last := make(map[string]string)
+	for {
+		results := checkStatuses(ctx, queried, ids, logger)
+		if ctx.Err() != nil {
+			return watchError(ctx, ids, targets)
+		}
+
+		reached := len(targets) > 0
+		for _, result := range results {
+			// Failures other than a missing resource won't go away by
+			// waiting, except throttling and other transient errors, which
+			// are retried until ctx expires
+			if result.Status == nil && result.failure != nil {
+				if !providers.IsRetryable(result.failure) {
+					return fmt.Errorf("failed to get status of %s: %w", result.ID, result.failure)
+				}
+				logger.Warnf("Failed to get status of %s, retrying: %v", result.ID, result.failure)
+				reached = false
+				continue
+			}
+
+			state := result.State()
+			if previous, seen := last[result.ID]; !seen || previous != state {
+				health := ""
+				if result.Status != nil && result.Status.Health != "" {
+					health = " (" + result.Status.Health + ")"
+				}
+				fmt.Fprintf(w, "%s  %s  %s%s\n", time.Now().Format("15:04:05"), result.ID, state, health)
+				last[result.ID] = state
+			}
+
+			if !stateIn(state, targets) {
+				reached = false
+			}
+		}
+
+		if reached {
+			fmt.Fprintf(w, "✅ %s reached %s\n", strings.Join(ids, ", "), strings.Join(targets, " or "))
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return watchError(ctx, ids, targets)
+		case <-time.After(interval):
+		}
+	}
+}
+
+// watchError explains why watching stopped
+func watchError(ctx context.Context, ids, targets []string) error {
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) && len(targets) > 0 {
+		return fmt.Errorf("timed out waiting for %s to reach %s", strings.Join(ids, ", "), strings.Join(targets, " or "))
+	}
+	return ctx.Err()
+}
+
+// stateIn reports whether a state is one of targets, case-insensitively
+func stateIn(state string, targets []string) bool {
+	for _, target := range targets {
+		if strings.EqualFold(state, strings.TrimSpace(target)) {
+			return true
+		}
+	}
+	return false
+}
+
+// writeStatusTable writes one row per resource
+func writeStatusTable(w io.Writer, results []ResourceStatusResult, header bool) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+	if header {
+		fmt.Fprintln(tw, "ID\tPROVIDER\tSTATE\tHEALTH\tLAST CHECKED")
+	}
+	for _, result := range results {
+		if result.Status == nil {
+			fmt.Fprintf(tw, "%s\t-\t%s\t-\t%s\n", result.ID, notFoundState, result.Error)
+			continue
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", result.ID, result.Provider, result.Status.State,
+			result.Status.Health, result.Status.LastChecked.Local().Format(time.RFC3339))
+	}
+	return tw.Flush()
+}
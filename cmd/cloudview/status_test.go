@@ -0,0 +1,117 @@
+package cloudview
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Tsahi-Elkayam/cloudview/pkg/models"
+	"github.com/Tsahi-Elkayam/cloudview/pkg/providers"
+	"github.com/Tsahi-Elkayam/cloudview/test/mocks"
+)
+
+// sequenceProvider reports a different state on each status check
+type sequenceProvider struct {
+	*mocks.MockAWSProvider
+	states []string
+}
+
+func (p *sequenceProvider) GetResourceStatus(ctx context.Context, resourceID string) (*models.ResourceStatus, error) {
+	state := p.states[0]
+	if len(p.states) > 1 {
+		p.states = p.states[1:]
+	}
+	return &models.ResourceStatus{State: state, Health: "warning", LastChecked: time.Now()}, nil
+}
+
+// failingProvider fails every status lookup
+type failingProvider struct {
+	*mocks.MockAWSProvider
+	calls int
+}
+
+func (p *failingProvider) GetResourceStatus(ctx context.Context, resourceID string) (*models.ResourceStatus, error) {
+	p.calls++
+	return nil, errors.New("AccessDenied: not authorized")
+}
+
+// throttledProvider is throttled a number of times before reporting a status
+type throttledProvider struct {
+	*mocks.MockAWSProvider
+	throttles int
+}
+
+func (p *throttledProvider) GetResourceStatus(ctx context.Context, resourceID string) (*models.ResourceStatus, error) {
+	if p.throttles > 0 {
+		p.throttles--
+		return nil, fmt.Errorf("ThrottlingException: rate exceeded: %w", providers.ErrRateLimitExceeded)
+	}
+	return &models.ResourceStatus{State: "available", LastChecked: time.Now()}, nil
+}
+
+func TestCheckStatuses(t *testing.T) {
+	mockProvider := mocks.NewMockAWSProvider()
+	mockProvider.AddResource(mocks.CreateMockEC2Instance("i-1", "web", "us-east-1", "running"))
+
+	results := checkStatuses(context.Background(), []providers.CloudProvider{mockProvider}, []string{"i-1", "i-2"}, logrus.New())
+	require.Len(t, results, 2)
+
+	assert.Equal(t, "aws", results[0].Provider)
+	assert.Equal(t, "running", results[0].State())
+	assert.Empty(t, results[0].Error)
+
+	assert.Nil(t, results[1].Status)
+	assert.Equal(t, notFoundState, results[1].State())
+	assert.Contains(t, results[1].Error, "aws:")
+
+	var buf bytes.Buffer
+	require.NoError(t, writeStatusTable(&buf, results, true))
+	assert.Contains(t, buf.String(), "LAST CHECKED")
+	assert.Contains(t, buf.String(), notFoundState)
+}
+
+func TestWatchStatuses(t *testing.T) {
+	provider := &sequenceProvider{
+		MockAWSProvider: mocks.NewMockAWSProvider(),
+		states:          []string{"modifying", "modifying", "backing-up", "available"},
+	}
+	queried := []providers.CloudProvider{provider}
+
+	var buf bytes.Buffer
+	err := watchStatuses(context.Background(), queried, []string{"orders-db"}, []string{"Available"}, time.Millisecond, &buf, logrus.New())
+	require.NoError(t, err)
+
+	// Only changes are printed
+	output := buf.String()
+	assert.Equal(t, 1, bytes.Count(buf.Bytes(), []byte("modifying")))
+	assert.Contains(t, output, "backing-up (warning)")
+	assert.Contains(t, output, "✅ orders-db reached Available")
+
+	// Waiting gives up when the context expires
+	provider.states = []string{"modifying"}
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	err = watchStatuses(ctx, queried, []string{"orders-db"}, []string{"available"}, time.Millisecond, &buf, logrus.New())
+	assert.EqualError(t, err, "timed out waiting for orders-db to reach available")
+
+	// A failed lookup stops waiting instead of polling until the timeout
+	failing := &failingProvider{MockAWSProvider: mocks.NewMockAWSProvider()}
+	err = watchStatuses(context.Background(), []providers.CloudProvider{failing}, []string{"orders-db"}, []string{"available"}, time.Millisecond, &buf, logrus.New())
+	assert.ErrorContains(t, err, "AccessDenied")
+	assert.Equal(t, 1, failing.calls)
+
+	// Throttling is retried until the resource reaches the target
+	buf.Reset()
+	throttled := &throttledProvider{MockAWSProvider: mocks.NewMockAWSProvider(), throttles: 3}
+	err = watchStatuses(context.Background(), []providers.CloudProvider{throttled}, []string{"orders-db"}, []string{"available"}, time.Millisecond, &buf, logrus.New())
+	require.NoError(t, err)
+	assert.NotContains(t, buf.String(), notFoundState)
+	assert.Contains(t, buf.String(), "✅ orders-db reached available")
+}
@@ -0,0 +1,322 @@
+package cloudview
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/Tsahi-Elkayam/cloudview/pkg/config"
+	"github.com/Tsahi-Elkayam/cloudview/pkg/output"
+)
+
+// NewViewCommand creates the saved view management command
+func NewViewCommand(logger *logrus.Logger) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "view",
+		Short: "Manage saved inventory views",
+		Long: `Manage named views: saved inventory queries stored under 'views:' in the
+configuration file, so a team can share standard queries instead of long flag strings.
+'view save' and 'view delete' only rewrite the 'views:' section; the rest of the
+file, including comments, is left as it is.
+
+Example configuration:
+  views:
+    prod-databases:
+      description: Production databases
+      types: [rds]
+      tags: [Environment=prod]
+      sort_by: [region, name]
+      columns: ID:.id,ENGINE:.metadata.engine,CLASS:.metadata.db_instance_class
+
+Examples:
+  # List saved views
+  cloudview view list
+
+  # Save a view from inventory flags
+  cloudview view save untagged --tag '!Owner' --description "Resources without an owner"
+
+  # Run a view, or use it as a starting point for inventory
+  cloudview view run prod-databases
+  cloudview inventory --view prod-databases --output json
+
+  # Delete a view
+  cloudview view delete untagged`,
+	}
+
+	cmd.AddCommand(NewViewListCommand(logger))
+	cmd.AddCommand(NewViewSaveCommand(logger))
+	cmd.AddCommand(NewViewDeleteCommand(logger))
+	cmd.AddCommand(NewViewRunCommand(logger))
+
+	return cmd
+}
+
+// NewViewListCommand lists saved views
+func NewViewListCommand(logger *logrus.Logger) *cobra.Command {
+	var format string
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List saved views",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg := GetGlobalConfig()
+			if cfg == nil {
+				return fmt.Errorf("configuration not loaded")
+			}
+
+			switch strings.ToLower(format) {
+			case "json":
+				return NewJSONEncoder(os.Stdout).Encode(cfg.Views)
+			case "yaml":
+				return NewYAMLEncoder(os.Stdout).Encode(cfg.Views)
+			}
+
+			names := cfg.ViewNames()
+			if len(names) == 0 {
+				fmt.Printf("ℹ️  No saved views in %s\n", viewConfigPath())
+				fmt.Printf("💡 Save one with: cloudview view save <name> --type ec2 --tag Environment=prod\n")
+				return nil
+			}
+
+			tw := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(tw, "NAME\tDESCRIPTION\tFLAGS")
+			for _, name := range names {
+				view := cfg.Views[name]
+				fmt.Fprintf(tw, "%s\t%s\t%s\n", name, view.Description, formatArgs(view.Args()))
+			}
+			return tw.Flush()
+		},
+	}
+
+	cmd.Flags().StringVarP(&format, "output", "o", "table", "Output format (table, json, yaml)")
+
+	return cmd
+}
+
+// NewViewSaveCommand saves a view to the configuration file
+func NewViewSaveCommand(logger *logrus.Logger) *cobra.Command {
+	var view config.ViewConfig
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "save <name>",
+		Short: "Save inventory flags as a named view",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := strings.ToLower(args[0])
+			if err := config.ValidateViewName(name); err != nil {
+				return err
+			}
+			if err := view.Validate(); err != nil {
+				return err
+			}
+
+			// Catch mistakes now rather than when the view is run
+			if err := validateView(view); err != nil {
+				return fmt.Errorf("invalid view: %w", err)
+			}
+
+			path := viewConfigPath()
+			cfg, err := config.DefaultLoader.LoadConfigFile(path)
+			if err != nil {
+				return err
+			}
+
+			if _, exists := cfg.GetView(name); exists && !force {
+				return fmt.Errorf("view %s already exists (use --force to replace it)", name)
+			}
+
+			if cfg.Views == nil {
+				cfg.Views = make(map[string]config.ViewConfig)
+			}
+			cfg.Views[name] = view
+
+			if err := config.DefaultLoader.SaveViews(cfg.Views, path); err != nil {
+				return err
+			}
+
+			fmt.Printf("✅ Saved view %s to %s\n", name, path)
+			fmt.Printf("💡 Run it with: cloudview view run %s\n", name)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&view.Description, "description", "", "Description shown by 'view list'")
+	cmd.Flags().StringSliceVarP(&view.Providers, "provider", "p", nil, "Cloud providers to query")
+	cmd.Flags().StringSliceVarP(&view.Regions, "region", "r", nil, "Regions to query")
+	cmd.Flags().StringSliceVarP(&view.Types, "type", "t", nil, "Resource types to include")
+	cmd.Flags().StringArrayVar(&view.Tags, "tag", nil, "Tag filters (same syntax as inventory --tag)")
+	cmd.Flags().StringSliceVarP(&view.Status, "status", "s", nil, "Resource status to include")
+	cmd.Flags().StringVar(&view.Filter, "filter", "", "Filter expression")
+	cmd.Flags().StringVar(&view.Query, "query", "", "JMESPath query")
+	cmd.Flags().StringVar(&view.Columns, "columns", "", "Table columns as a custom-columns spec, e.g. ID:.id,TYPE:.type")
+	cmd.Flags().StringSliceVar(&view.SortBy, "sort-by", nil, "Sort keys")
+	cmd.Flags().StringVar(&view.GroupBy, "group-by", "", "Group table output by a field")
+	cmd.Flags().StringVarP(&view.Output, "output", "o", "", "Output format")
+	cmd.Flags().BoolVar(&force, "force", false, "Replace an existing view")
+
+	return cmd
+}
+
+// NewViewDeleteCommand deletes a view from the configuration file
+func NewViewDeleteCommand(logger *logrus.Logger) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "delete <name>",
+		Aliases: []string{"rm"},
+		Short:   "Delete a saved view",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := strings.ToLower(args[0])
+			path := viewConfigPath()
+
+			cfg, err := config.DefaultLoader.LoadConfigFile(path)
+			if err != nil {
+				return err
+			}
+
+			if _, exists := cfg.GetView(name); !exists {
+				return fmt.Errorf("view %s not found in %s", name, path)
+			}
+			delete(cfg.Views, name)
+
+			if err := config.DefaultLoader.SaveViews(cfg.Views, path); err != nil {
+				return err
+			}
+
+			fmt.Printf("🗑️  Deleted view %s from %s\n", name, path)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// NewViewRunCommand runs a saved view. It accepts every inventory flag, so
+// parts of the view can be overridden.
+func NewViewRunCommand(logger *logrus.Logger) *cobra.Command {
+	cmd := NewInventoryCommand(logger)
+	cmd.Use = "run <name>"
+	cmd.Short = "Run a saved view"
+	cmd.Long = `Run a saved view. Inventory flags override the view's settings.
+
+Examples:
+  cloudview view run prod-databases
+  cloudview view run prod-databases --region eu-west-1 --output csv`
+	cmd.Args = cobra.ExactArgs(1)
+
+	runInventory := cmd.RunE
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		if err := cmd.Flags().Set("view", args[0]); err != nil {
+			return err
+		}
+		return runInventory(cmd, args)
+	}
+
+	return cmd
+}
+
+// applyView sets inventory flags from a saved view, skipping flags given on
+// the command line
+func applyView(cmd *cobra.Command, name string) error {
+	cfg := GetGlobalConfig()
+	if cfg == nil {
+		return fmt.Errorf("configuration not loaded")
+	}
+
+	view, ok := cfg.GetView(name)
+	if !ok {
+		return fmt.Errorf("view %s not found (see 'cloudview view list')", name)
+	}
+
+	// Check before setting anything, since repeated flags like --tag are
+	// marked as changed by the first value
+	args := view.Args()
+	explicit := make(map[string]bool)
+	for i := 0; i < len(args); i += 2 {
+		flag := strings.TrimPrefix(args[i], "--")
+		explicit[flag] = cmd.Flags().Changed(flag)
+	}
+
+	for i := 0; i < len(args); i += 2 {
+		flag := strings.TrimPrefix(args[i], "--")
+		if explicit[flag] {
+			continue
+		}
+		if err := cmd.Flags().Set(flag, args[i+1]); err != nil {
+			return fmt.Errorf("invalid %s in view %s: %w", flag, name, err)
+		}
+	}
+
+	return nil
+}
+
+// validateView checks a view's filters, output format, query and sort keys
+func validateView(view config.ViewConfig) error {
+	opts := &InventoryOptions{
+		Regions:       view.Regions,
+		ResourceTypes: view.Types,
+		Tags:          view.Tags,
+		Status:        view.Status,
+		Filter:        view.Filter,
+		SortBy:        view.SortBy,
+		GroupBy:       view.GroupBy,
+		Output:        view.Output,
+		Query:         view.Query,
+	}
+	if _, err := parseInventoryFilters(opts); err != nil {
+		return err
+	}
+	if _, err := inventorySortKeys(opts); err != nil {
+		return err
+	}
+	if err := validateStreamOptions(opts); err != nil {
+		return err
+	}
+
+	if view.Columns != "" {
+		if err := validateOutputFormat("custom-columns=" + view.Columns); err != nil {
+			return err
+		}
+	} else if view.Output != "" {
+		if err := validateOutputFormat(view.Output); err != nil {
+			return err
+		}
+	}
+
+	if view.Query != "" {
+		if _, err := output.CompileQuery(view.Query); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// viewConfigPath returns the configuration file views are saved to: the
+// --config file, the file that was loaded, or the default location
+func viewConfigPath() string {
+	if cfgFile != "" {
+		return cfgFile
+	}
+	if path := config.DefaultLoader.ConfigFileUsed(); path != "" {
+		return path
+	}
+	return config.DefaultLoader.GetConfigPath()
+}
+
+// formatArgs renders command line arguments, quoting values for the shell
+func formatArgs(args []string) string {
+	quoted := make([]string, len(args))
+	for i, arg := range args {
+		if strings.HasPrefix(arg, "--") || !strings.ContainsAny(arg, " \t!|*?()[]{}<>&;$'\"`\\~") {
+			quoted[i] = arg
+			continue
+		}
+		quoted[i] = "'" + strings.ReplaceAll(arg, "'", `'\''`) + "'"
+	}
+	return strings.Join(quoted, " ")
+}
@@ -0,0 +1,66 @@
+package cloudview
+
+import (
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Tsahi-Elkayam/cloudview/pkg/config"
+)
+
+func TestApplyView(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Views = map[string]config.ViewConfig{
+		"prod-databases": {
+			Types:   []string{"rds"},
+			Regions: []string{"us-east-1", "us-west-2"},
+			Tags:    []string{"Environment=prod", "!Owner"},
+			SortBy:  []string{"-created_at"},
+			Columns: "ID:.id,ENGINE:.metadata.engine",
+		},
+	}
+	previous := globalConfig
+	globalConfig = cfg
+	defer func() { globalConfig = previous }()
+
+	cmd := NewInventoryCommand(logrus.New())
+	require.NoError(t, cmd.ParseFlags([]string{"--view", "prod-databases", "--region", "eu-west-1"}))
+	require.NoError(t, applyView(cmd, "prod-databases"))
+
+	get := func(name string) []string {
+		values, err := cmd.Flags().GetStringSlice(name)
+		require.NoError(t, err)
+		return values
+	}
+
+	// Explicit flags win over the view
+	assert.Equal(t, []string{"eu-west-1"}, get("region"))
+	assert.Equal(t, []string{"rds"}, get("type"))
+	tags, err := cmd.Flags().GetStringArray("tag")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Environment=prod", "!Owner"}, tags)
+	assert.Equal(t, []string{"-created_at"}, get("sort-by"))
+
+	outputFormat, err := cmd.Flags().GetString("output")
+	require.NoError(t, err)
+	assert.Equal(t, "custom-columns=ID:.id,ENGINE:.metadata.engine", outputFormat)
+
+	assert.Error(t, applyView(cmd, "missing"))
+}
+
+func TestValidateView(t *testing.T) {
+	assert.NoError(t, validateView(config.ViewConfig{Types: []string{"ec2"}, Output: "csv"}))
+	assert.Error(t, validateView(config.ViewConfig{Types: []string{"teleporter"}}))
+	assert.Error(t, validateView(config.ViewConfig{Output: "pdf"}))
+	assert.Error(t, validateView(config.ViewConfig{Query: "resources[?"}))
+	assert.Error(t, validateView(config.ViewConfig{Columns: "ID"}))
+	assert.NoError(t, validateView(config.ViewConfig{Output: "jsonl"}))
+	assert.Error(t, validateView(config.ViewConfig{Output: "jsonl", SortBy: []string{"name"}}))
+}
+
+func TestFormatArgs(t *testing.T) {
+	assert.Equal(t, `--type rds --tag Environment=prod --tag '!Owner' --filter 'name == "web"'`,
+		formatArgs([]string{"--type", "rds", "--tag", "Environment=prod", "--tag", "!Owner", "--filter", `name == "web"`}))
+}
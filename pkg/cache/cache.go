@@ -0,0 +1,154 @@
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/Tsahi-Elkayam/cloudview/pkg/config"
+)
+
+// ErrEntryTooLarge is returned by Set when a single value exceeds the store's
+// maximum size and can therefore never be kept
+var ErrEntryTooLarge = errors.New("cache entry exceeds the maximum cache size")
+
+// Store is the interface implemented by all cache backends
+type Store interface {
+	// Get returns the cached value for key, or false if it is missing or expired
+	Get(key string) ([]byte, bool)
+
+	// Set stores a value under key for the given time-to-live
+	Set(key string, value []byte, ttl time.Duration) error
+
+	// Delete removes a single entry
+	Delete(key string) error
+
+	// Clear removes all entries
+	Clear() error
+
+	// Prune removes expired entries and returns how many were removed
+	Prune() (int, error)
+
+	// Entries returns information about all stored entries
+	Entries() ([]EntryInfo, error)
+
+	// Stats returns usage statistics for the store
+	Stats() (Stats, error)
+}
+
+// EntryInfo describes a single cache entry
+type EntryInfo struct {
+	Key          string    `json:"key"`
+	Size         int64     `json:"size"`
+	CreatedAt    time.Time `json:"created_at"`
+	ExpiresAt    time.Time `json:"expires_at"`
+	LastAccessed time.Time `json:"last_accessed"`
+}
+
+// Expired returns whether the entry has passed its expiry time
+func (e EntryInfo) Expired() bool {
+	return time.Now().After(e.ExpiresAt)
+}
+
+// Stats holds cache usage statistics
+type Stats struct {
+	Storage   string `json:"storage"`
+	Directory string `json:"directory,omitempty"`
+	Entries   int    `json:"entries"`
+	Expired   int    `json:"expired"`
+	TotalSize int64  `json:"total_size"`
+	MaxSize   int64  `json:"max_size"`
+}
+
+// NewStore creates a cache store from the cache configuration
+func NewStore(cfg config.CacheConfig) (Store, error) {
+	var maxSize int64
+	if cfg.MaxSize != "" {
+		size, err := ParseSize(cfg.MaxSize)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cache max_size: %w", err)
+		}
+		maxSize = size
+	}
+
+	switch cfg.Storage {
+	case "memory", "":
+		return NewMemoryStore(maxSize), nil
+	case "disk":
+		return NewDiskStore(cfg.Directory, maxSize)
+	default:
+		return nil, fmt.Errorf("unsupported cache storage: %s", cfg.Storage)
+	}
+}
+
+// DefaultDirectory returns the directory used for disk storage when none is configured
+func DefaultDirectory() string {
+	return filepath.Join(os.TempDir(), "cloudview-cache")
+}
+
+// collectStats builds statistics from a list of entries
+func collectStats(storage string, entries []EntryInfo, maxSize int64) Stats {
+	stats := Stats{
+		Storage: storage,
+		Entries: len(entries),
+		MaxSize: maxSize,
+	}
+
+	for _, entry := range entries {
+		stats.TotalSize += entry.Size
+		if entry.Expired() {
+			stats.Expired++
+		}
+	}
+
+	return stats
+}
+
+// evictionCandidates returns the entries to remove so that the total size fits
+// within maxSize, least recently used first
+func evictionCandidates(entries []EntryInfo, maxSize int64) []EntryInfo {
+	if maxSize <= 0 {
+		return nil
+	}
+
+	var total int64
+	for _, entry := range entries {
+		total += entry.Size
+	}
+	if total <= maxSize {
+		return nil
+	}
+
+	sorted := append([]EntryInfo(nil), entries...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].LastAccessed.Before(sorted[j].LastAccessed)
+	})
+
+	var evict []EntryInfo
+	for _, entry := range sorted {
+		if total <= maxSize {
+			break
+		}
+		evict = append(evict, entry)
+		total -= entry.Size
+	}
+
+	return evict
+}
+
+// Key builds a deterministic cache key from the JSON encoding of the given value
+func Key(v interface{}) (string, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode cache key: %w", err)
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
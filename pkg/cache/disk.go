@@ -0,0 +1,288 @@
+package cache
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// diskEntryExt is the file extension used for cache entries
+const diskEntryExt = ".cache"
+
+// diskEntry is the on-disk representation of a cache entry
+type diskEntry struct {
+	Key       string    `json:"key"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+	Value     []byte    `json:"value"`
+}
+
+// DiskStore is a persistent cache store that keeps one file per entry.
+// File modification times track last access for least-recently-used eviction.
+type DiskStore struct {
+	directory string
+	maxSize   int64
+	mu        sync.Mutex
+}
+
+// NewDiskStore creates a disk-backed cache store in the given directory.
+// An empty directory uses DefaultDirectory; a maxSize of zero means unbounded.
+func NewDiskStore(directory string, maxSize int64) (*DiskStore, error) {
+	if directory == "" {
+		directory = DefaultDirectory()
+	}
+
+	if err := os.MkdirAll(directory, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create cache directory: %w", err)
+	}
+
+	return &DiskStore{
+		directory: directory,
+		maxSize:   maxSize,
+	}, nil
+}
+
+// Directory returns the directory holding the cache entries
+func (s *DiskStore) Directory() string {
+	return s.directory
+}
+
+// Get returns the cached value for key if it has not expired
+func (s *DiskStore) Get(key string) ([]byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	path := s.entryPath(key)
+	entry, err := readDiskEntry(path)
+	if err != nil {
+		return nil, false
+	}
+
+	now := time.Now()
+	if now.After(entry.ExpiresAt) {
+		os.Remove(path)
+		return nil, false
+	}
+
+	// Record the access for LRU eviction
+	os.Chtimes(path, now, now)
+
+	return entry.Value, true
+}
+
+// Set stores a value under key for the given time-to-live.
+// An entry larger than maxSize is not written and ErrEntryTooLarge is returned.
+func (s *DiskStore) Set(key string, value []byte, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	data, err := json.Marshal(diskEntry{
+		Key:       key,
+		CreatedAt: now,
+		ExpiresAt: now.Add(ttl),
+		Value:     value,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode cache entry: %w", err)
+	}
+
+	// Writing the entry would only evict it again straight away; drop any
+	// older value for the key so it isn't served in place of the new one
+	if s.maxSize > 0 && int64(len(data)) > s.maxSize {
+		os.Remove(s.entryPath(key))
+		return fmt.Errorf("%w: %s for %s", ErrEntryTooLarge, FormatSize(int64(len(data))), FormatSize(s.maxSize))
+	}
+
+	// Write to a temporary file first so readers never see partial entries
+	tmp, err := os.CreateTemp(s.directory, "tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create cache entry: %w", err)
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write cache entry: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write cache entry: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), s.entryPath(key)); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to store cache entry: %w", err)
+	}
+
+	return s.evict()
+}
+
+// Delete removes a single entry
+func (s *DiskStore) Delete(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := os.Remove(s.entryPath(key)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to delete cache entry: %w", err)
+	}
+
+	return nil
+}
+
+// Clear removes all entries
+func (s *DiskStore) Clear() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	files, err := s.entryFiles()
+	if err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		if err := os.Remove(file); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to delete cache entry: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Prune removes expired entries
+func (s *DiskStore) Prune() (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entries, err := s.entryInfos()
+	if err != nil {
+		return 0, err
+	}
+
+	removed := 0
+	for _, entry := range entries {
+		if entry.Expired() {
+			if err := os.Remove(s.entryPath(entry.Key)); err == nil {
+				removed++
+			}
+		}
+	}
+
+	return removed, nil
+}
+
+// Entries returns information about all stored entries
+func (s *DiskStore) Entries() ([]EntryInfo, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.entryInfos()
+}
+
+// Stats returns usage statistics for the store
+func (s *DiskStore) Stats() (Stats, error) {
+	entries, err := s.Entries()
+	if err != nil {
+		return Stats{}, err
+	}
+
+	stats := collectStats("disk", entries, s.maxSize)
+	stats.Directory = s.directory
+	return stats, nil
+}
+
+// evict removes least recently used entries until the store fits in maxSize;
+// the caller must hold the lock
+func (s *DiskStore) evict() error {
+	if s.maxSize <= 0 {
+		return nil
+	}
+
+	entries, err := s.entryInfos()
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range evictionCandidates(entries, s.maxSize) {
+		if err := os.Remove(s.entryPath(entry.Key)); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to evict cache entry: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// entryInfos reads information about all entries; the caller must hold the lock
+func (s *DiskStore) entryInfos() ([]EntryInfo, error) {
+	files, err := s.entryFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]EntryInfo, 0, len(files))
+	for _, file := range files {
+		stat, err := os.Stat(file)
+		if err != nil {
+			continue
+		}
+
+		entry, err := readDiskEntry(file)
+		if err != nil {
+			// Unreadable entries are treated as expired so they get pruned
+			entry = &diskEntry{Key: strings.TrimSuffix(filepath.Base(file), diskEntryExt)}
+		}
+
+		infos = append(infos, EntryInfo{
+			Key:          entry.Key,
+			Size:         stat.Size(),
+			CreatedAt:    entry.CreatedAt,
+			ExpiresAt:    entry.ExpiresAt,
+			LastAccessed: stat.ModTime(),
+		})
+	}
+
+	return infos, nil
+}
+
+// entryFiles lists the entry files in the cache directory
+func (s *DiskStore) entryFiles() ([]string, error) {
+	dirEntries, err := os.ReadDir(s.directory)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read cache directory: %w", err)
+	}
+
+	var files []string
+	for _, dirEntry := range dirEntries {
+		if dirEntry.IsDir() || !strings.HasSuffix(dirEntry.Name(), diskEntryExt) {
+			continue
+		}
+		files = append(files, filepath.Join(s.directory, dirEntry.Name()))
+	}
+
+	return files, nil
+}
+
+// entryPath returns the file path for a key
+func (s *DiskStore) entryPath(key string) string {
+	return filepath.Join(s.directory, key+diskEntryExt)
+}
+
+// readDiskEntry reads and decodes an entry file
+func readDiskEntry(path string) (*diskEntry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var entry diskEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, fmt.Errorf("failed to decode cache entry %s: %w", path, err)
+	}
+
+	return &entry, nil
+}
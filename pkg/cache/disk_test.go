@@ -0,0 +1,151 @@
+package cache
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Tsahi-Elkayam/cloudview/pkg/config"
+)
+
+func TestDiskStorePersistence(t *testing.T) {
+	dir := t.TempDir()
+
+	store, err := NewDiskStore(dir, 0)
+	require.NoError(t, err)
+	require.NoError(t, store.Set("key", []byte("value"), time.Minute))
+
+	// A second store on the same directory sees the entry
+	reopened, err := NewDiskStore(dir, 0)
+	require.NoError(t, err)
+
+	value, ok := reopened.Get("key")
+	assert.True(t, ok)
+	assert.Equal(t, []byte("value"), value)
+
+	require.NoError(t, reopened.Delete("key"))
+	_, ok = store.Get("key")
+	assert.False(t, ok)
+}
+
+func TestDiskStoreExpiryAndPrune(t *testing.T) {
+	store, err := NewDiskStore(t.TempDir(), 0)
+	require.NoError(t, err)
+
+	require.NoError(t, store.Set("fresh", []byte("1"), time.Minute))
+	require.NoError(t, store.Set("stale", []byte("2"), -time.Second))
+
+	stats, err := store.Stats()
+	require.NoError(t, err)
+	assert.Equal(t, 2, stats.Entries)
+	assert.Equal(t, 1, stats.Expired)
+
+	removed, err := store.Prune()
+	require.NoError(t, err)
+	assert.Equal(t, 1, removed)
+
+	entries, err := store.Entries()
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "fresh", entries[0].Key)
+}
+
+func TestDiskStoreEviction(t *testing.T) {
+	dir := t.TempDir()
+
+	store, err := NewDiskStore(dir, 0)
+	require.NoError(t, err)
+
+	value := make([]byte, 100)
+	require.NoError(t, store.Set("oldest", value, time.Minute))
+	require.NoError(t, store.Set("used", value, time.Minute))
+
+	// Make the access order explicit regardless of filesystem timestamp resolution
+	past := time.Now().Add(-time.Hour)
+	require.NoError(t, os.Chtimes(filepath.Join(dir, "oldest"+diskEntryExt), past, past))
+	require.NoError(t, os.Chtimes(filepath.Join(dir, "used"+diskEntryExt), past.Add(time.Minute), past.Add(time.Minute)))
+	_, ok := store.Get("used")
+	require.True(t, ok)
+
+	// Limit the store to roughly two entries and add a third
+	entries, err := store.Entries()
+	require.NoError(t, err)
+	store.maxSize = entries[0].Size * 2
+
+	require.NoError(t, store.Set("newest", value, time.Minute))
+
+	_, ok = store.Get("oldest")
+	assert.False(t, ok)
+	_, ok = store.Get("used")
+	assert.True(t, ok)
+	_, ok = store.Get("newest")
+	assert.True(t, ok)
+}
+
+func TestDiskStoreRejectsOversizedEntry(t *testing.T) {
+	store, err := NewDiskStore(t.TempDir(), 512)
+	require.NoError(t, err)
+
+	require.NoError(t, store.Set("key", []byte("small"), time.Minute))
+
+	err = store.Set("key", make([]byte, 1024), time.Minute)
+	assert.ErrorIs(t, err, ErrEntryTooLarge)
+
+	// The stale value is dropped rather than served in place of the new one
+	_, ok := store.Get("key")
+	assert.False(t, ok)
+
+	entries, err := store.Entries()
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestDiskStoreClear(t *testing.T) {
+	store, err := NewStore(config.CacheConfig{Storage: "disk", Directory: t.TempDir(), MaxSize: "1MB"})
+	require.NoError(t, err)
+
+	require.NoError(t, store.Set("a", []byte("1"), time.Minute))
+	require.NoError(t, store.Clear())
+
+	stats, err := store.Stats()
+	require.NoError(t, err)
+	assert.Equal(t, 0, stats.Entries)
+	assert.Equal(t, int64(1<<20), stats.MaxSize)
+}
+
+func TestParseSize(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    int64
+		wantErr bool
+	}{
+		{input: "100MB", want: 100 << 20},
+		{input: "1.5GB", want: 3 << 29},
+		{input: "512kb", want: 512 << 10},
+		{input: "2 MiB", want: 2 << 20},
+		{input: "1024", want: 1024},
+		{input: "", wantErr: true},
+		{input: "lots", wantErr: true},
+		{input: "-1MB", wantErr: true},
+		{input: "inf", wantErr: true},
+		{input: "NaN", wantErr: true},
+		{input: "1e400", wantErr: true},
+		{input: "1e30GB", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseSize(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package cache
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// memoryEntry holds a cached value and its bookkeeping times
+type memoryEntry struct {
+	value        []byte
+	createdAt    time.Time
+	expiresAt    time.Time
+	lastAccessed time.Time
+}
+
+// MemoryStore is an in-process cache store
+type MemoryStore struct {
+	entries map[string]*memoryEntry
+	maxSize int64
+	mu      sync.Mutex
+}
+
+// NewMemoryStore creates a new in-memory cache store.
+// A maxSize of zero means the store is unbounded.
+func NewMemoryStore(maxSize int64) *MemoryStore {
+	return &MemoryStore{
+		entries: make(map[string]*memoryEntry),
+		maxSize: maxSize,
+	}
+}
+
+// Get returns the cached value for key if it has not expired
+func (s *MemoryStore) Get(key string) ([]byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, exists := s.entries[key]
+	if !exists {
+		return nil, false
+	}
+
+	now := time.Now()
+	if now.After(entry.expiresAt) {
+		delete(s.entries, key)
+		return nil, false
+	}
+
+	entry.lastAccessed = now
+	return entry.value, true
+}
+
+// Set stores a value under key for the given time-to-live.
+// A value larger than maxSize is not stored and ErrEntryTooLarge is returned.
+func (s *MemoryStore) Set(key string, value []byte, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.maxSize > 0 && int64(len(value)) > s.maxSize {
+		delete(s.entries, key)
+		return fmt.Errorf("%w: %s for %s", ErrEntryTooLarge, FormatSize(int64(len(value))), FormatSize(s.maxSize))
+	}
+
+	now := time.Now()
+	s.entries[key] = &memoryEntry{
+		value:        value,
+		createdAt:    now,
+		expiresAt:    now.Add(ttl),
+		lastAccessed: now,
+	}
+
+	for _, entry := range evictionCandidates(s.entryInfos(), s.maxSize) {
+		delete(s.entries, entry.Key)
+	}
+
+	return nil
+}
+
+// Delete removes a single entry
+func (s *MemoryStore) Delete(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.entries, key)
+	return nil
+}
+
+// Clear removes all entries
+func (s *MemoryStore) Clear() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.entries = make(map[string]*memoryEntry)
+	return nil
+}
+
+// Prune removes expired entries
+func (s *MemoryStore) Prune() (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	removed := 0
+	now := time.Now()
+	for key, entry := range s.entries {
+		if now.After(entry.expiresAt) {
+			delete(s.entries, key)
+			removed++
+		}
+	}
+
+	return removed, nil
+}
+
+// Entries returns information about all stored entries
+func (s *MemoryStore) Entries() ([]EntryInfo, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.entryInfos(), nil
+}
+
+// Stats returns usage statistics for the store
+func (s *MemoryStore) Stats() (Stats, error) {
+	entries, err := s.Entries()
+	if err != nil {
+		return Stats{}, err
+	}
+
+	return collectStats("memory", entries, s.maxSize), nil
+}
+
+// entryInfos lists the entries; the caller must hold the lock
+func (s *MemoryStore) entryInfos() []EntryInfo {
+	infos := make([]EntryInfo, 0, len(s.entries))
+	for key, entry := range s.entries {
+		infos = append(infos, EntryInfo{
+			Key:          key,
+			Size:         int64(len(entry.value)),
+			CreatedAt:    entry.createdAt,
+			ExpiresAt:    entry.expiresAt,
+			LastAccessed: entry.lastAccessed,
+		})
+	}
+
+	return infos
+}
@@ -0,0 +1,76 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Tsahi-Elkayam/cloudview/pkg/config"
+)
+
+func TestMemoryStore(t *testing.T) {
+	store := NewMemoryStore(0)
+
+	require.NoError(t, store.Set("key", []byte("value"), time.Minute))
+
+	value, ok := store.Get("key")
+	assert.True(t, ok)
+	assert.Equal(t, []byte("value"), value)
+
+	_, ok = store.Get("missing")
+	assert.False(t, ok)
+
+	require.NoError(t, store.Delete("key"))
+	_, ok = store.Get("key")
+	assert.False(t, ok)
+}
+
+func TestMemoryStoreExpiry(t *testing.T) {
+	store := NewMemoryStore(0)
+
+	require.NoError(t, store.Set("key", []byte("value"), -time.Second))
+
+	_, ok := store.Get("key")
+	assert.False(t, ok)
+}
+
+func TestMemoryStoreClear(t *testing.T) {
+	store := NewMemoryStore(0)
+
+	require.NoError(t, store.Set("a", []byte("1"), time.Minute))
+	require.NoError(t, store.Set("b", []byte("2"), time.Minute))
+	require.NoError(t, store.Clear())
+
+	_, ok := store.Get("a")
+	assert.False(t, ok)
+	_, ok = store.Get("b")
+	assert.False(t, ok)
+}
+
+func TestKey(t *testing.T) {
+	type query struct {
+		Provider string            `json:"provider"`
+		Tags     map[string]string `json:"tags"`
+	}
+
+	a, err := Key(query{Provider: "aws", Tags: map[string]string{"Team": "backend", "Env": "prod"}})
+	require.NoError(t, err)
+	b, err := Key(query{Provider: "aws", Tags: map[string]string{"Env": "prod", "Team": "backend"}})
+	require.NoError(t, err)
+	c, err := Key(query{Provider: "aws", Tags: map[string]string{"Env": "dev"}})
+	require.NoError(t, err)
+
+	assert.Equal(t, a, b)
+	assert.NotEqual(t, a, c)
+}
+
+func TestNewStore(t *testing.T) {
+	store, err := NewStore(config.CacheConfig{Storage: "memory"})
+	require.NoError(t, err)
+	assert.IsType(t, &MemoryStore{}, store)
+
+	_, err = NewStore(config.CacheConfig{Storage: "redis"})
+	assert.Error(t, err)
+}
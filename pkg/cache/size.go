@@ -0,0 +1,67 @@
+package cache
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// sizeUnits maps size suffixes to their multipliers, longest suffixes first
+var sizeUnits = []struct {
+	suffix     string
+	multiplier float64
+}{
+	{"TIB", 1 << 40}, {"GIB", 1 << 30}, {"MIB", 1 << 20}, {"KIB", 1 << 10},
+	{"TB", 1 << 40}, {"GB", 1 << 30}, {"MB", 1 << 20}, {"KB", 1 << 10},
+	{"T", 1 << 40}, {"G", 1 << 30}, {"M", 1 << 20}, {"K", 1 << 10},
+	{"B", 1},
+}
+
+// ParseSize parses a human-readable size such as "100MB" or "1.5GB" into bytes.
+// Units are binary (1KB = 1024 bytes); a bare number is a byte count.
+func ParseSize(s string) (int64, error) {
+	value := strings.ToUpper(strings.TrimSpace(s))
+	if value == "" {
+		return 0, fmt.Errorf("size cannot be empty")
+	}
+
+	multiplier := 1.0
+	for _, unit := range sizeUnits {
+		if strings.HasSuffix(value, unit.suffix) {
+			multiplier = unit.multiplier
+			value = strings.TrimSpace(strings.TrimSuffix(value, unit.suffix))
+			break
+		}
+	}
+
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil || number < 0 {
+		return 0, fmt.Errorf("invalid size: %s (expected a value like 100MB)", s)
+	}
+
+	// ParseFloat accepts "Inf", "NaN" and out-of-range exponents, none of
+	// which make a usable limit
+	bytes := number * multiplier
+	if math.IsNaN(bytes) || math.IsInf(bytes, 0) || bytes >= math.MaxInt64 {
+		return 0, fmt.Errorf("invalid size: %s (value out of range)", s)
+	}
+
+	return int64(bytes), nil
+}
+
+// FormatSize formats a byte count as a human-readable size
+func FormatSize(bytes int64) string {
+	const unit = 1024
+	if bytes < unit {
+		return fmt.Sprintf("%dB", bytes)
+	}
+
+	div, exp := int64(unit), 0
+	for n := bytes / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f%cB", float64(bytes)/float64(div), "KMGT"[exp])
+}
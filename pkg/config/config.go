@@ -11,6 +11,7 @@ type Config struct {
 	Cache     CacheConfig              `yaml:"cache" json:"cache"`
 	Output    OutputConfig             `yaml:"output" json:"output"`
 	Logging   LoggingConfig            `yaml:"logging" json:"logging"`
+	Views     map[string]ViewConfig    `yaml:"views,omitempty" json:"views,omitempty"`
 }
 
 // ProviderConfig is the interface for all provider configurations
@@ -117,7 +118,7 @@ type CacheConfig struct {
 
 // OutputConfig represents output configuration
 type OutputConfig struct {
-	Format   string `yaml:"format" json:"format"`     // table, json, yaml, excel
+	Format   string `yaml:"format" json:"format"`     // table, json, jsonl, yaml, csv, tsv, html, markdown, excel
 	Colors   bool   `yaml:"colors" json:"colors"`
 	MaxWidth int    `yaml:"max_width" json:"max_width"`
 	NoHeader bool   `yaml:"no_header" json:"no_header"`
@@ -189,7 +190,7 @@ func (c *Config) Validate() error {
 	}
 	
 	// Validate output config
-	validFormats := []string{"table", "json", "yaml", "excel"}
+	validFormats := []string{"table", "json", "jsonl", "yaml", "csv", "tsv", "html", "markdown", "excel"}
 	validFormat := false
 	for _, format := range validFormats {
 		if c.Output.Format == format {
@@ -214,6 +215,16 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("logging level must be one of: %v", validLevels)
 	}
 	
+	// Validate saved views
+	for name, view := range c.Views {
+		if err := ValidateViewName(name); err != nil {
+			return err
+		}
+		if err := view.Validate(); err != nil {
+			return fmt.Errorf("invalid view %s: %w", name, err)
+		}
+	}
+	
 	return nil
 }
 
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"bytes"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -12,9 +13,10 @@ import (
 
 // Loader handles configuration loading from various sources
 type Loader struct {
-	configPaths []string
-	configName  string
-	configType  string
+	configPaths    []string
+	configName     string
+	configType     string
+	configFileUsed string
 }
 
 // NewLoader creates a new configuration loader
@@ -70,6 +72,7 @@ func (l *Loader) LoadConfig(configFile string) (*Config, error) {
 	} else {
 		configFileExists = true
 		configFilePath = v.ConfigFileUsed()
+		l.configFileUsed = configFilePath
 		fmt.Printf("Using config file: %s\n", configFilePath)
 	}
 	
@@ -136,6 +139,13 @@ func (l *Loader) mergeWithDefaults(v *viper.Viper, defaultConfig *Config) error
 		}
 	}
 	
+	// Merge saved views
+	if views, exists := userConfig["views"]; exists {
+		if err := l.mergeStruct(views, &defaultConfig.Views); err != nil {
+			return fmt.Errorf("failed to merge views: %w", err)
+		}
+	}
+	
 	return nil
 }
 
@@ -293,6 +303,110 @@ func (l *Loader) SaveConfig(config *Config, filePath string) error {
 	return nil
 }
 
+// SaveViews replaces the views section of a configuration file, leaving the
+// rest of the file, including comments, untouched. Saving the merged
+// configuration instead would write every default into the user's file.
+func (l *Loader) SaveViews(views map[string]ViewConfig, filePath string) error {
+	data, err := os.ReadFile(filePath)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to read config file: %w", err)
+	}
+	
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return fmt.Errorf("failed to parse config file: %w", err)
+	}
+	
+	// A missing or empty file, possibly holding only comments
+	if len(doc.Content) == 0 {
+		doc.Kind = yaml.DocumentNode
+		doc.Content = []*yaml.Node{{Kind: yaml.MappingNode, Tag: "!!map"}}
+	}
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return fmt.Errorf("config file %s is not a YAML mapping", filePath)
+	}
+	
+	var value yaml.Node
+	if err := value.Encode(views); err != nil {
+		return fmt.Errorf("failed to marshal views: %w", err)
+	}
+	
+	// Mapping nodes hold keys and values alternately
+	index := -1
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		if root.Content[i].Value == "views" {
+			index = i
+			break
+		}
+	}
+	
+	switch {
+	case len(views) == 0 && index >= 0:
+		root.Content = append(root.Content[:index], root.Content[index+2:]...)
+	case len(views) == 0:
+		// Nothing to save or remove
+	case index >= 0:
+		root.Content[index+1] = &value
+	default:
+		key := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: "views"}
+		root.Content = append(root.Content, key, &value)
+	}
+	
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&doc); err != nil {
+		return fmt.Errorf("failed to marshal config: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return fmt.Errorf("failed to marshal config: %w", err)
+	}
+	
+	if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+	if err := os.WriteFile(filePath, buf.Bytes(), 0644); err != nil {
+		return fmt.Errorf("failed to write config file: %w", err)
+	}
+	
+	return nil
+}
+
+// LoadConfigFile loads a single configuration file over the defaults,
+// ignoring environment variables, so it can be edited and saved back without
+// persisting overrides from the environment. A missing file yields the defaults.
+func (l *Loader) LoadConfigFile(filePath string) (*Config, error) {
+	config := DefaultConfig()
+	
+	if _, err := os.Stat(filePath); os.IsNotExist(err) {
+		return config, nil
+	}
+	
+	v := viper.New()
+	v.SetConfigType(l.configType)
+	v.SetConfigFile(filePath)
+	if err := v.ReadInConfig(); err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+	
+	if err := l.mergeWithDefaults(v, config); err != nil {
+		return nil, fmt.Errorf("failed to merge configuration: %w", err)
+	}
+	
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("configuration validation failed: %w", err)
+	}
+	
+	return config, nil
+}
+
+// ConfigFileUsed returns the configuration file read by the last LoadConfig
+// call, or an empty string if none was found
+func (l *Loader) ConfigFileUsed() string {
+	return l.configFileUsed
+}
+
 // GenerateExampleConfig generates an example configuration file with comments
 func (l *Loader) GenerateExampleConfig(filePath string) error {
 	// Create YAML content with helpful comments
@@ -329,12 +443,13 @@ providers:
 # cache:
 #   enabled: true
 #   ttl: "5m"
-#   storage: "memory"  # or "disk"
-#   max_size: "100MB"
+#   storage: "memory"  # or "disk" to share results between invocations
+#   max_size: "100MB"  # least recently used entries are evicted beyond this
+#   directory: "/tmp/cloudview-cache"  # for disk storage
 
 # Optional: Override output settings  
 # output:
-#   format: "table"  # table, json, yaml
+#   format: "table"  # table, json, jsonl, yaml, csv, tsv, html, markdown, excel
 #   colors: true
 #   max_width: 0  # 0 = auto
 
@@ -345,6 +460,16 @@ providers:
 #   color: true
 #   # file: "/path/to/logfile"
 
+# Optional: Named views, run with 'cloudview view run <name>' or
+# 'cloudview inventory --view <name>'
+# views:
+#   prod-databases:
+#     description: "Production databases"
+#     types: ["rds"]
+#     tags: ["Environment=prod"]
+#     sort_by: ["region", "name"]
+#     columns: "ID:.id,ENGINE:.metadata.engine,CLASS:.metadata.db_instance_class"
+
 # Environment Variable Examples:
 # Instead of this file, you can use environment variables:
 # export CLOUDVIEW_AWS_PROFILE=myprofile
@@ -0,0 +1,94 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// ViewConfig is a named, saved inventory query. Every field is optional and
+// corresponds to the inventory flag of the same name.
+type ViewConfig struct {
+	Description string   `yaml:"description,omitempty" json:"description,omitempty"`
+	Providers   []string `yaml:"providers,omitempty" json:"providers,omitempty"`
+	Regions     []string `yaml:"regions,omitempty" json:"regions,omitempty"`
+	Types       []string `yaml:"types,omitempty" json:"types,omitempty"`
+	Tags        []string `yaml:"tags,omitempty" json:"tags,omitempty"`
+	Status      []string `yaml:"status,omitempty" json:"status,omitempty"`
+	Filter      string   `yaml:"filter,omitempty" json:"filter,omitempty"`
+	Query       string   `yaml:"query,omitempty" json:"query,omitempty"`
+	Columns     string   `yaml:"columns,omitempty" json:"columns,omitempty"` // custom-columns spec, e.g. ID:.id,TYPE:.type
+	SortBy      []string `yaml:"sort_by,omitempty" json:"sort_by,omitempty"`
+	GroupBy     string   `yaml:"group_by,omitempty" json:"group_by,omitempty"`
+	Output      string   `yaml:"output,omitempty" json:"output,omitempty"`
+}
+
+// viewNamePattern restricts view names to characters that survive the
+// case-insensitive, dot-separated keys of the config loader
+var viewNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+// ValidateViewName checks that a view name can be stored in the configuration
+func ValidateViewName(name string) error {
+	if !viewNamePattern.MatchString(name) {
+		return fmt.Errorf("invalid view name %q: use lowercase letters, digits, '-' and '_'", name)
+	}
+	return nil
+}
+
+// Validate checks a view for settings that can't be combined
+func (v ViewConfig) Validate() error {
+	if v.Columns != "" && v.Output != "" && v.Output != "table" {
+		return fmt.Errorf("columns can only be used with table output, not %s", v.Output)
+	}
+	return nil
+}
+
+// Args returns the inventory flags equivalent to the view
+func (v ViewConfig) Args() []string {
+	var args []string
+	addList := func(flag string, values []string) {
+		if len(values) > 0 {
+			args = append(args, flag, strings.Join(values, ","))
+		}
+	}
+	add := func(flag, value string) {
+		if value != "" {
+			args = append(args, flag, value)
+		}
+	}
+
+	addList("--provider", v.Providers)
+	addList("--region", v.Regions)
+	addList("--type", v.Types)
+	for _, tag := range v.Tags {
+		add("--tag", tag)
+	}
+	addList("--status", v.Status)
+	add("--filter", v.Filter)
+	add("--query", v.Query)
+	addList("--sort-by", v.SortBy)
+	add("--group-by", v.GroupBy)
+	if v.Columns != "" {
+		add("--output", "custom-columns="+v.Columns)
+	} else {
+		add("--output", v.Output)
+	}
+	return args
+}
+
+// GetView returns a saved view by name
+func (c *Config) GetView(name string) (ViewConfig, bool) {
+	view, ok := c.Views[strings.ToLower(name)]
+	return view, ok
+}
+
+// ViewNames returns the names of all saved views, sorted
+func (c *Config) ViewNames() []string {
+	names := make([]string, 0, len(c.Views))
+	for name := range c.Views {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,94 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestViewArgs(t *testing.T) {
+	view := ViewConfig{
+		Types:   []string{"rds", "aurora"},
+		Regions: []string{"us-east-1"},
+		Tags:    []string{"Environment=prod", "!Owner"},
+		Filter:  `metadata.multi_az == false`,
+		Columns: "ID:.id,ENGINE:.metadata.engine",
+		SortBy:  []string{"region", "-created_at"},
+	}
+
+	assert.Equal(t, []string{
+		"--region", "us-east-1",
+		"--type", "rds,aurora",
+		"--tag", "Environment=prod",
+		"--tag", "!Owner",
+		"--filter", `metadata.multi_az == false`,
+		"--sort-by", "region,-created_at",
+		"--output", "custom-columns=ID:.id,ENGINE:.metadata.engine",
+	}, view.Args())
+}
+
+func TestValidateViews(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Views = map[string]ViewConfig{"prod-databases": {Types: []string{"rds"}}}
+	require.NoError(t, cfg.Validate())
+
+	cfg.Views = map[string]ViewConfig{"Prod DBs": {}}
+	assert.Error(t, cfg.Validate())
+
+	cfg.Views = map[string]ViewConfig{"prod": {Columns: "ID:.id", Output: "json"}}
+	assert.Error(t, cfg.Validate())
+}
+
+func TestSaveAndLoadViews(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cloudview.yaml")
+	original := "# Team settings\noutput:\n  format: json # for scripts\n"
+	require.NoError(t, os.WriteFile(path, []byte(original), 0644))
+
+	loader := NewLoader()
+	cfg, err := loader.LoadConfigFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "json", cfg.Output.Format)
+	assert.Empty(t, cfg.Views)
+
+	cfg.Views = map[string]ViewConfig{
+		"prod-databases": {
+			Description: "Production databases",
+			Types:       []string{"rds"},
+			Tags:        []string{"Environment=prod"},
+			Output:      "table",
+		},
+	}
+	require.NoError(t, loader.SaveViews(cfg.Views, path))
+
+	// Only the views are added; comments stay and no defaults are written
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(data), original), string(data))
+	assert.NotContains(t, string(data), "cache")
+
+	loaded, err := loader.LoadConfigFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "json", loaded.Output.Format)
+	assert.Equal(t, cfg.Cache.TTL, loaded.Cache.TTL)
+
+	view, ok := loaded.GetView("prod-databases")
+	require.True(t, ok)
+	assert.Equal(t, cfg.Views["prod-databases"], view)
+	assert.Equal(t, []string{"prod-databases"}, loaded.ViewNames())
+
+	// Deleting the last view removes the section
+	require.NoError(t, loader.SaveViews(nil, path))
+	data, err = os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, original, string(data))
+}
+
+func TestLoadConfigFileMissing(t *testing.T) {
+	cfg, err := NewLoader().LoadConfigFile(filepath.Join(t.TempDir(), "missing.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, DefaultConfig().Output, cfg.Output)
+}
@@ -0,0 +1,235 @@
+// Package inventory provides a programmatic, paginated view of the resources
+// discovered by cloud providers, for embedding cloudview as a library or
+// serving it over HTTP.
+package inventory
+
+import (
+	"container/heap"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Tsahi-Elkayam/cloudview/pkg/models"
+	"github.com/Tsahi-Elkayam/cloudview/pkg/providers"
+	"github.com/Tsahi-Elkayam/cloudview/pkg/types"
+)
+
+const (
+	// DefaultPageSize is used when a request doesn't set a page size
+	DefaultPageSize = 100
+	// MaxPageSize is the largest page size a request may ask for
+	MaxPageSize = 1000
+)
+
+// Client pages through the resources of a set of providers
+type Client struct {
+	providers []providers.CloudProvider
+	logger    *logrus.Logger
+}
+
+// ListOptions selects a page of resources
+type ListOptions struct {
+	Filters types.ResourceFilters
+
+	// PageSize is the number of resources per page, DefaultPageSize if zero
+	PageSize int
+
+	// Cursor is the NextCursor of the previous page, empty for the first page
+	Cursor string
+}
+
+// NewClient creates an inventory client for authenticated providers
+func NewClient(providerList []providers.CloudProvider, logger *logrus.Logger) *Client {
+	if logger == nil {
+		logger = logrus.New()
+	}
+
+	return &Client{
+		providers: providerList,
+		logger:    logger,
+	}
+}
+
+// ListResources returns a page of resources ordered by provider, region,
+// type and ID, then native type and ARN for resources sharing an ID. Result.Data holds the page's []models.Resource and
+// Result.Pagination its position, the total number of matching resources
+// and the cursor of the next page.
+//
+// Cursors identify the last resource of a page rather than an offset, so
+// paging stays consistent when resources are created or deleted between
+// requests. Resources are streamed from the providers and only one page is
+// kept in memory; put providers behind a providers.CachedProvider to avoid
+// querying the cloud APIs for every page. Services that fail are listed in
+// Result.Metadata["errors"].
+func (c *Client) ListResources(ctx context.Context, opts ListOptions) (*models.Result, error) {
+	pageSize := opts.PageSize
+	switch {
+	case pageSize == 0:
+		pageSize = DefaultPageSize
+	case pageSize < 0 || pageSize > MaxPageSize:
+		return nil, fmt.Errorf("page size must be between 1 and %d, got %d", MaxPageSize, pageSize)
+	}
+
+	var after *cursorKey
+	if opts.Cursor != "" {
+		key, err := decodeCursor(opts.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		after = &key
+	}
+
+	page := &pageHeap{}
+	var total, before int
+	var serviceErrors []string
+
+	for _, provider := range c.providers {
+		events, err := providers.StreamResources(ctx, provider, opts.Filters)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get resources from %s: %w", provider.Name(), err)
+		}
+
+		for event := range events {
+			switch event.Type {
+			case types.DiscoveryEventResource:
+				total++
+				key := keyOf(event.Resource)
+				if after != nil && key.compare(*after) <= 0 {
+					before++
+					continue
+				}
+
+				// Keep the pageSize smallest resources after the cursor
+				heap.Push(page, pageEntry{key: key, resource: *event.Resource})
+				if page.Len() > pageSize {
+					heap.Pop(page)
+				}
+			case types.DiscoveryEventServiceDone:
+				if event.Err != nil {
+					c.logger.Warn(event.Err)
+					serviceErrors = append(serviceErrors, fmt.Sprintf("%s: %v", event.Provider, event.Err))
+				}
+			}
+		}
+
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Popping the max-heap yields the page in reverse order
+	resources := make([]models.Resource, page.Len())
+	for i := len(resources) - 1; i >= 0; i-- {
+		resources[i] = heap.Pop(page).(pageEntry).resource
+	}
+
+	pagination := &models.PaginationInfo{
+		Page:    before/pageSize + 1,
+		PerPage: pageSize,
+		Total:   total,
+		HasNext: before+len(resources) < total,
+		HasPrev: before > 0,
+	}
+	if pagination.HasNext {
+		pagination.NextCursor = keyOf(&resources[len(resources)-1]).encode()
+	}
+
+	result := &models.Result{
+		Data:       resources,
+		Pagination: pagination,
+	}
+	if len(serviceErrors) > 0 {
+		result.Metadata = map[string]interface{}{"errors": serviceErrors}
+	}
+	return result, nil
+}
+
+// cursorKey is the position of a resource in the inventory order. IDs are
+// only unique within a native type (an RDS instance and cluster, or a
+// DynamoDB table, can share a name), so the native type and ARN break ties.
+type cursorKey struct {
+	Provider   string `json:"p"`
+	Region     string `json:"r"`
+	Type       string `json:"t"`
+	ID         string `json:"i"`
+	NativeType string `json:"n,omitempty"`
+	ARN        string `json:"a,omitempty"`
+}
+
+// keyOf returns the position of a resource
+func keyOf(resource *models.Resource) cursorKey {
+	return cursorKey{
+		Provider:   resource.Provider,
+		Region:     resource.Region,
+		Type:       resource.Type,
+		ID:         resource.ID,
+		NativeType: resource.NativeType,
+		ARN:        resource.ARN,
+	}
+}
+
+// compare orders keys by provider, region, type, ID, native type and ARN
+func (k cursorKey) compare(other cursorKey) int {
+	if c := strings.Compare(k.Provider, other.Provider); c != 0 {
+		return c
+	}
+	if c := strings.Compare(k.Region, other.Region); c != 0 {
+		return c
+	}
+	if c := strings.Compare(k.Type, other.Type); c != 0 {
+		return c
+	}
+	if c := strings.Compare(k.ID, other.ID); c != 0 {
+		return c
+	}
+	if c := strings.Compare(k.NativeType, other.NativeType); c != 0 {
+		return c
+	}
+	return strings.Compare(k.ARN, other.ARN)
+}
+
+// encode returns the key as an opaque, URL-safe cursor
+func (k cursorKey) encode() string {
+	data, _ := json.Marshal(k)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeCursor parses a cursor returned by ListResources
+func decodeCursor(cursor string) (cursorKey, error) {
+	var key cursorKey
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return key, fmt.Errorf("invalid cursor: %w", err)
+	}
+	if err := json.Unmarshal(data, &key); err != nil {
+		return key, fmt.Errorf("invalid cursor: %w", err)
+	}
+	return key, nil
+}
+
+// pageEntry is a resource held while selecting a page
+type pageEntry struct {
+	key      cursorKey
+	resource models.Resource
+}
+
+// pageHeap is a max-heap of entries, so the largest can be dropped once a
+// page is full
+type pageHeap []pageEntry
+
+func (h pageHeap) Len() int           { return len(h) }
+func (h pageHeap) Less(i, j int) bool { return h[i].key.compare(h[j].key) > 0 }
+func (h pageHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+
+func (h *pageHeap) Push(x interface{}) { *h = append(*h, x.(pageEntry)) }
+
+func (h *pageHeap) Pop() interface{} {
+	old := *h
+	entry := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return entry
+}
@@ -0,0 +1,171 @@
+package inventory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Tsahi-Elkayam/cloudview/pkg/models"
+	"github.com/Tsahi-Elkayam/cloudview/pkg/providers"
+	"github.com/Tsahi-Elkayam/cloudview/pkg/types"
+	"github.com/Tsahi-Elkayam/cloudview/test/mocks"
+)
+
+func testClient(t *testing.T) (*Client, *mocks.MockAWSProvider) {
+	t.Helper()
+
+	mockProvider := mocks.NewMockAWSProvider()
+	mockProvider.SetAuthenticated(true)
+	for i := 5; i >= 1; i-- {
+		mockProvider.AddResource(mocks.CreateMockEC2Instance(fmt.Sprintf("i-%d", i), fmt.Sprintf("web-%d", i), "us-west-2", "running"))
+	}
+	mockProvider.AddResource(mocks.CreateMockEC2Instance("i-9", "api", "us-east-1", "running"))
+	mockProvider.AddResource(mocks.CreateMockS3Bucket("logs", "us-east-1"))
+
+	return NewClient([]providers.CloudProvider{mockProvider}, logrus.New()), mockProvider
+}
+
+func pageIDs(t *testing.T, result *models.Result) []string {
+	t.Helper()
+
+	resources, ok := result.Data.([]models.Resource)
+	require.True(t, ok)
+
+	ids := make([]string, len(resources))
+	for i, resource := range resources {
+		ids[i] = resource.ID
+	}
+	return ids
+}
+
+func TestListResources(t *testing.T) {
+	ctx := context.Background()
+	client, _ := testClient(t)
+
+	var pages [][]string
+	var infos []models.PaginationInfo
+	cursor := ""
+	for {
+		result, err := client.ListResources(ctx, ListOptions{PageSize: 3, Cursor: cursor})
+		require.NoError(t, err)
+
+		pages = append(pages, pageIDs(t, result))
+		infos = append(infos, *result.Pagination)
+
+		cursor = result.Pagination.NextCursor
+		if cursor == "" {
+			break
+		}
+	}
+
+	// Sorted by provider, region, type and ID
+	assert.Equal(t, [][]string{
+		{"i-9", "logs", "i-1"},
+		{"i-2", "i-3", "i-4"},
+		{"i-5"},
+	}, pages)
+
+	for i, info := range infos {
+		assert.Equal(t, i+1, info.Page)
+		assert.Equal(t, 3, info.PerPage)
+		assert.Equal(t, 7, info.Total)
+		assert.Equal(t, i > 0, info.HasPrev)
+		assert.Equal(t, i < 2, info.HasNext)
+	}
+}
+
+func TestListResourcesStableCursor(t *testing.T) {
+	ctx := context.Background()
+	client, mockProvider := testClient(t)
+
+	first, err := client.ListResources(ctx, ListOptions{PageSize: 4})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"i-9", "logs", "i-1", "i-2"}, pageIDs(t, first))
+
+	// A resource created before the cursor doesn't shift the next page
+	mockProvider.AddResource(mocks.CreateMockEC2Instance("i-0", "new", "us-west-2", "pending"))
+
+	second, err := client.ListResources(ctx, ListOptions{PageSize: 4, Cursor: first.Pagination.NextCursor})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"i-3", "i-4", "i-5"}, pageIDs(t, second))
+	assert.Equal(t, 8, second.Pagination.Total)
+	assert.False(t, second.Pagination.HasNext)
+	assert.Empty(t, second.Pagination.NextCursor)
+}
+
+func TestListResourcesSharedID(t *testing.T) {
+	ctx := context.Background()
+
+	// An RDS instance, an RDS cluster and a DynamoDB table can share a name
+	mockProvider := mocks.NewMockAWSProvider()
+	mockProvider.SetAuthenticated(true)
+	for _, nativeType := range []string{models.NativeTypeDynamoDBTable, models.NativeTypeRDSInstance, models.NativeTypeRDSCluster} {
+		resource := *models.NewResource("orders", "orders", string(models.ResourceTypeDatabase), "aws", "us-east-1")
+		resource.NativeType = nativeType
+		resource.ARN = "arn:" + nativeType
+		mockProvider.AddResource(resource)
+	}
+	client := NewClient([]providers.CloudProvider{mockProvider}, logrus.New())
+
+	var nativeTypes []string
+	cursor := ""
+	for {
+		result, err := client.ListResources(ctx, ListOptions{PageSize: 2, Cursor: cursor})
+		require.NoError(t, err)
+		for _, resource := range result.Data.([]models.Resource) {
+			nativeTypes = append(nativeTypes, resource.NativeType)
+		}
+
+		cursor = result.Pagination.NextCursor
+		if cursor == "" {
+			break
+		}
+	}
+
+	// The page boundary falls between resources with the same key otherwise
+	assert.Equal(t, []string{models.NativeTypeDynamoDBTable, models.NativeTypeRDSCluster, models.NativeTypeRDSInstance}, nativeTypes)
+}
+
+func TestListResourcesFilters(t *testing.T) {
+	client, _ := testClient(t)
+
+	result, err := client.ListResources(context.Background(), ListOptions{
+		Filters: types.ResourceFilters{Regions: []string{"us-east-1"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"i-9", "logs"}, pageIDs(t, result))
+	assert.Equal(t, DefaultPageSize, result.Pagination.PerPage)
+	assert.Equal(t, 2, result.Pagination.Total)
+	assert.False(t, result.Pagination.HasNext)
+}
+
+func TestListResourcesErrors(t *testing.T) {
+	ctx := context.Background()
+	client, mockProvider := testClient(t)
+
+	tests := []struct {
+		name string
+		opts ListOptions
+	}{
+		{"negative page size", ListOptions{PageSize: -1}},
+		{"page size too large", ListOptions{PageSize: MaxPageSize + 1}},
+		{"malformed cursor", ListOptions{Cursor: "not a cursor!"}},
+		{"cursor is not a position", ListOptions{Cursor: "bm90IGpzb24"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.ListResources(ctx, tt.opts)
+			assert.Error(t, err)
+		})
+	}
+
+	mockProvider.SetError("GetResources", errors.New("api unavailable"))
+	_, err := client.ListResources(ctx, ListOptions{})
+	assert.Error(t, err)
+}
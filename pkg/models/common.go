@@ -139,11 +139,12 @@ type Recommendation struct {
 
 // PaginationInfo represents pagination metadata
 type PaginationInfo struct {
-	Page    int  `json:"page"`
-	PerPage int  `json:"per_page"`
-	Total   int  `json:"total"`
-	HasNext bool `json:"has_next"`
-	HasPrev bool `json:"has_prev"`
+	Page       int    `json:"page"`
+	PerPage    int    `json:"per_page"`
+	Total      int    `json:"total"`
+	HasNext    bool   `json:"has_next"`
+	HasPrev    bool   `json:"has_prev"`
+	NextCursor string `json:"next_cursor,omitempty"` // Opaque cursor for the following page
 }
 
 // Result represents a generic result with data and metadata
@@ -4,19 +4,23 @@ import (
 	"time"
 )
 
-// Resource represents a cloud resource across any provider
+// Resource represents a cloud resource across any provider. Type is a
+// normalized ResourceType, while NativeType is the provider's own type
+// such as "AWS::EC2::Instance".
 type Resource struct {
-	ID        string                 `json:"id"`
-	Name      string                 `json:"name"`
-	Type      string                 `json:"type"`
-	Provider  string                 `json:"provider"`
-	Region    string                 `json:"region"`
-	Status    ResourceStatus         `json:"status"`
-	Tags      map[string]string      `json:"tags"`
-	CreatedAt time.Time              `json:"created_at"`
-	UpdatedAt time.Time              `json:"updated_at"`
-	Metadata  map[string]interface{} `json:"metadata"`
-	Cost      *ResourceCost          `json:"cost,omitempty"`
+	ID         string                 `json:"id"`
+	Name       string                 `json:"name"`
+	Type       string                 `json:"type"`
+	NativeType string                 `json:"native_type,omitempty"`
+	ARN        string                 `json:"arn,omitempty"`
+	Provider   string                 `json:"provider"`
+	Region     string                 `json:"region"`
+	Status     ResourceStatus         `json:"status"`
+	Tags       map[string]string      `json:"tags"`
+	CreatedAt  time.Time              `json:"created_at"`
+	UpdatedAt  time.Time              `json:"updated_at"`
+	Metadata   map[string]interface{} `json:"metadata"`
+	Cost       *ResourceCost          `json:"cost,omitempty"`
 }
 
 // ResourceType defines common resource types across cloud providers
@@ -79,52 +83,25 @@ const (
 	HealthUnknown   ResourceHealth = "unknown"
 )
 
-// GetResourceTypeFromString converts a string to ResourceType
+// GetResourceTypeFromString converts a type name or alias to ResourceType.
+// Names that select several types, such as "iam", return ResourceTypeUnknown.
 func GetResourceTypeFromString(s string) ResourceType {
-	switch s {
-	case "virtual_machine", "vm", "ec2", "compute_engine", "virtual_machines":
-		return ResourceTypeVirtualMachine
-	case "container", "containers", "ecs", "gke", "aci":
-		return ResourceTypeContainer
-	case "function", "functions", "lambda", "cloud_functions", "azure_functions":
-		return ResourceTypeFunction
-	case "cluster", "clusters", "eks", "gke_cluster", "aks":
-		return ResourceTypeCluster
-	case "object_storage", "s3", "gcs", "blob_storage":
-		return ResourceTypeObjectStorage
-	case "block_storage", "ebs", "persistent_disk", "managed_disk":
-		return ResourceTypeBlockStorage
-	case "file_storage", "efs", "filestore", "azure_files":
-		return ResourceTypeFileStorage
-	case "database", "databases", "rds", "cloud_sql", "cosmos_db":
-		return ResourceTypeDatabase
-	case "vpc", "network", "vnet":
-		return ResourceTypeVPC
-	case "subnet", "subnets":
-		return ResourceTypeSubnet
-	case "load_balancer", "lb", "elb", "alb", "nlb":
-		return ResourceTypeLoadBalancer
-	case "security_group", "firewall", "nsg":
-		return ResourceTypeSecurityGroup
-	case "gateway", "nat_gateway", "internet_gateway":
-		return ResourceTypeGateway
-	case "user", "users":
-		return ResourceTypeUser
-	case "role", "roles":
-		return ResourceTypeRole
-	case "policy", "policies":
-		return ResourceTypePolicy
-	case "secret", "secrets":
-		return ResourceTypeSecret
-	case "metric", "metrics":
-		return ResourceTypeMetric
-	case "alarm", "alarms", "alert":
-		return ResourceTypeAlarm
-	case "dashboard", "dashboards":
-		return ResourceTypeDashboard
-	default:
+	if resourceType, ok := normalizedResourceType(s); ok {
+		return resourceType
+	}
+
+	selectors, ok := ResolveResourceType(s)
+	if !ok {
 		return ResourceTypeUnknown
 	}
+
+	resourceType := selectors[0].Type
+	for _, selector := range selectors[1:] {
+		if selector.Type != resourceType {
+			return ResourceTypeUnknown
+		}
+	}
+	return resourceType
 }
 
 // String returns the string representation of ResourceType
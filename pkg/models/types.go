@@ -0,0 +1,270 @@
+package models
+
+import (
+	"sort"
+	"strings"
+)
+
+// Provider-native resource types, in CloudFormation notation for AWS
+const (
+	NativeTypeEC2Instance       = "AWS::EC2::Instance"
+	NativeTypeS3Bucket          = "AWS::S3::Bucket"
+	NativeTypeRDSInstance       = "AWS::RDS::DBInstance"
+	NativeTypeRDSCluster        = "AWS::RDS::DBCluster"
+	NativeTypeIAMUser           = "AWS::IAM::User"
+	NativeTypeIAMRole           = "AWS::IAM::Role"
+	NativeTypeIAMPolicy         = "AWS::IAM::ManagedPolicy"
+	NativeTypeVPC               = "AWS::EC2::VPC"
+	NativeTypeEC2SecurityGroup  = "AWS::EC2::SecurityGroup"
+	NativeTypeLambdaFunction    = "AWS::Lambda::Function"
+	NativeTypeEBSVolume         = "AWS::EC2::Volume"
+	NativeTypeEBSSnapshot       = "AWS::EC2::Snapshot"
+	NativeTypeELBv2LoadBalancer = "AWS::ElasticLoadBalancingV2::LoadBalancer"
+	NativeTypeECSCluster        = "AWS::ECS::Cluster"
+	NativeTypeECSService        = "AWS::ECS::Service"
+	NativeTypeECSTask           = "AWS::ECS::Task"
+	NativeTypeDynamoDBTable     = "AWS::DynamoDB::Table"
+)
+
+// nativeResourceTypes maps provider-native types to their normalized type
+var nativeResourceTypes = map[string]ResourceType{
+	NativeTypeEC2Instance:       ResourceTypeVirtualMachine,
+	NativeTypeS3Bucket:          ResourceTypeObjectStorage,
+	NativeTypeRDSInstance:       ResourceTypeDatabase,
+	NativeTypeRDSCluster:        ResourceTypeDatabase,
+	NativeTypeIAMUser:           ResourceTypeUser,
+	NativeTypeIAMRole:           ResourceTypeRole,
+	NativeTypeIAMPolicy:         ResourceTypePolicy,
+	NativeTypeVPC:               ResourceTypeVPC,
+	NativeTypeEC2SecurityGroup:  ResourceTypeSecurityGroup,
+	NativeTypeLambdaFunction:    ResourceTypeFunction,
+	NativeTypeEBSVolume:         ResourceTypeBlockStorage,
+	NativeTypeEBSSnapshot:       ResourceTypeBlockStorage,
+	NativeTypeELBv2LoadBalancer: ResourceTypeLoadBalancer,
+	NativeTypeECSCluster:        ResourceTypeContainer,
+	NativeTypeECSService:        ResourceTypeContainer,
+	NativeTypeECSTask:           ResourceTypeContainer,
+	NativeTypeDynamoDBTable:     ResourceTypeDatabase,
+}
+
+// resourceTypes lists every normalized resource type
+var resourceTypes = []ResourceType{
+	ResourceTypeVirtualMachine, ResourceTypeContainer, ResourceTypeFunction, ResourceTypeCluster,
+	ResourceTypeObjectStorage, ResourceTypeBlockStorage, ResourceTypeFileStorage, ResourceTypeDatabase,
+	ResourceTypeVPC, ResourceTypeSubnet, ResourceTypeLoadBalancer, ResourceTypeSecurityGroup, ResourceTypeGateway,
+	ResourceTypeUser, ResourceTypeRole, ResourceTypePolicy, ResourceTypeSecret,
+	ResourceTypeMetric, ResourceTypeAlarm, ResourceTypeDashboard,
+}
+
+// TypeSelector selects resources of a normalized type, optionally narrowed
+// to a single provider-native type
+type TypeSelector struct {
+	Type       ResourceType `json:"type"`
+	NativeType string       `json:"native_type,omitempty"`
+}
+
+// Selects reports whether the selector includes a resource with the given
+// normalized and native types. An empty native type means it isn't known,
+// which matches any native type of the normalized type.
+func (s TypeSelector) Selects(resourceType ResourceType, nativeType string) bool {
+	if s.Type != resourceType {
+		return false
+	}
+	return s.NativeType == "" || nativeType == "" || strings.EqualFold(s.NativeType, nativeType)
+}
+
+// resourceTypeAliases maps the alternative names accepted for resource types
+// to what they select. Normalized types and native types always select
+// themselves and don't need to be listed.
+var resourceTypeAliases = map[string][]TypeSelector{
+	// Compute resources
+	"vm":               {{Type: ResourceTypeVirtualMachine}},
+	"virtual_machines": {{Type: ResourceTypeVirtualMachine}},
+	"ec2":              {{Type: ResourceTypeVirtualMachine, NativeType: NativeTypeEC2Instance}},
+	"instance":         {{Type: ResourceTypeVirtualMachine}},
+	"instances":        {{Type: ResourceTypeVirtualMachine}},
+	"compute_engine":   {{Type: ResourceTypeVirtualMachine}},
+	"containers":       {{Type: ResourceTypeContainer}},
+	"ecs":              {{Type: ResourceTypeContainer, NativeType: NativeTypeECSCluster}, {Type: ResourceTypeContainer, NativeType: NativeTypeECSService}, {Type: ResourceTypeContainer, NativeType: NativeTypeECSTask}},
+	"ecs_cluster":      {{Type: ResourceTypeContainer, NativeType: NativeTypeECSCluster}},
+	"ecs_service":      {{Type: ResourceTypeContainer, NativeType: NativeTypeECSService}},
+	"ecs_services":     {{Type: ResourceTypeContainer, NativeType: NativeTypeECSService}},
+	"ecs_task":         {{Type: ResourceTypeContainer, NativeType: NativeTypeECSTask}},
+	"ecs_tasks":        {{Type: ResourceTypeContainer, NativeType: NativeTypeECSTask}},
+	"gke":              {{Type: ResourceTypeContainer}},
+	"aci":              {{Type: ResourceTypeContainer}},
+	"functions":        {{Type: ResourceTypeFunction}},
+	"lambda":           {{Type: ResourceTypeFunction, NativeType: NativeTypeLambdaFunction}},
+	"cloud_functions":  {{Type: ResourceTypeFunction}},
+	"azure_functions":  {{Type: ResourceTypeFunction}},
+	"clusters":         {{Type: ResourceTypeCluster}, {Type: ResourceTypeDatabase, NativeType: NativeTypeRDSCluster}},
+	"eks":              {{Type: ResourceTypeCluster}},
+	"gke_cluster":      {{Type: ResourceTypeCluster}},
+	"aks":              {{Type: ResourceTypeCluster}},
+
+	// Storage resources
+	"s3":              {{Type: ResourceTypeObjectStorage, NativeType: NativeTypeS3Bucket}},
+	"bucket":          {{Type: ResourceTypeObjectStorage}},
+	"buckets":         {{Type: ResourceTypeObjectStorage}},
+	"gcs":             {{Type: ResourceTypeObjectStorage}},
+	"blob_storage":    {{Type: ResourceTypeObjectStorage}},
+	"ebs":             {{Type: ResourceTypeBlockStorage, NativeType: NativeTypeEBSVolume}, {Type: ResourceTypeBlockStorage, NativeType: NativeTypeEBSSnapshot}},
+	"volume":          {{Type: ResourceTypeBlockStorage, NativeType: NativeTypeEBSVolume}},
+	"volumes":         {{Type: ResourceTypeBlockStorage, NativeType: NativeTypeEBSVolume}},
+	"ebs_volume":      {{Type: ResourceTypeBlockStorage, NativeType: NativeTypeEBSVolume}},
+	"snapshot":        {{Type: ResourceTypeBlockStorage, NativeType: NativeTypeEBSSnapshot}},
+	"snapshots":       {{Type: ResourceTypeBlockStorage, NativeType: NativeTypeEBSSnapshot}},
+	"ebs_snapshot":    {{Type: ResourceTypeBlockStorage, NativeType: NativeTypeEBSSnapshot}},
+	"persistent_disk": {{Type: ResourceTypeBlockStorage}},
+	"managed_disk":    {{Type: ResourceTypeBlockStorage}},
+	"efs":             {{Type: ResourceTypeFileStorage}},
+	"filestore":       {{Type: ResourceTypeFileStorage}},
+	"azure_files":     {{Type: ResourceTypeFileStorage}},
+	"databases":       {{Type: ResourceTypeDatabase}},
+	"rds":             {{Type: ResourceTypeDatabase, NativeType: NativeTypeRDSInstance}, {Type: ResourceTypeDatabase, NativeType: NativeTypeRDSCluster}},
+	"rds_instance":    {{Type: ResourceTypeDatabase, NativeType: NativeTypeRDSInstance}},
+	"postgres":        {{Type: ResourceTypeDatabase, NativeType: NativeTypeRDSInstance}},
+	"postgresql":      {{Type: ResourceTypeDatabase, NativeType: NativeTypeRDSInstance}},
+	"mysql":           {{Type: ResourceTypeDatabase, NativeType: NativeTypeRDSInstance}},
+	"rds_cluster":     {{Type: ResourceTypeDatabase, NativeType: NativeTypeRDSCluster}},
+	"aurora":          {{Type: ResourceTypeDatabase, NativeType: NativeTypeRDSCluster}},
+	"dynamodb":        {{Type: ResourceTypeDatabase, NativeType: NativeTypeDynamoDBTable}},
+	"dynamo":          {{Type: ResourceTypeDatabase, NativeType: NativeTypeDynamoDBTable}},
+	"dynamodb_table":  {{Type: ResourceTypeDatabase, NativeType: NativeTypeDynamoDBTable}},
+	"cloud_sql":       {{Type: ResourceTypeDatabase}},
+	"cosmos_db":       {{Type: ResourceTypeDatabase}},
+
+	// Network resources
+	"network":          {{Type: ResourceTypeVPC}},
+	"vnet":             {{Type: ResourceTypeVPC}},
+	"subnets":          {{Type: ResourceTypeSubnet}},
+	"lb":               {{Type: ResourceTypeLoadBalancer}},
+	"elb":              {{Type: ResourceTypeLoadBalancer}},
+	"alb":              {{Type: ResourceTypeLoadBalancer, NativeType: NativeTypeELBv2LoadBalancer}},
+	"nlb":              {{Type: ResourceTypeLoadBalancer, NativeType: NativeTypeELBv2LoadBalancer}},
+	"firewall":         {{Type: ResourceTypeSecurityGroup}},
+	"sg":               {{Type: ResourceTypeSecurityGroup}},
+	"nsg":              {{Type: ResourceTypeSecurityGroup}},
+	"nat_gateway":      {{Type: ResourceTypeGateway}},
+	"internet_gateway": {{Type: ResourceTypeGateway}},
+
+	// IAM resources
+	"iam":        {{Type: ResourceTypeUser}, {Type: ResourceTypeRole}, {Type: ResourceTypePolicy}},
+	"users":      {{Type: ResourceTypeUser}},
+	"iam_user":   {{Type: ResourceTypeUser, NativeType: NativeTypeIAMUser}},
+	"roles":      {{Type: ResourceTypeRole}},
+	"iam_role":   {{Type: ResourceTypeRole, NativeType: NativeTypeIAMRole}},
+	"policies":   {{Type: ResourceTypePolicy}},
+	"iam_policy": {{Type: ResourceTypePolicy, NativeType: NativeTypeIAMPolicy}},
+	"secrets":    {{Type: ResourceTypeSecret}},
+
+	// Monitoring resources
+	"metrics":    {{Type: ResourceTypeMetric}},
+	"alarms":     {{Type: ResourceTypeAlarm}},
+	"alert":      {{Type: ResourceTypeAlarm}},
+	"dashboards": {{Type: ResourceTypeDashboard}},
+}
+
+// normalizedTypeExtras lists what normalized type names select besides their
+// own type. "cluster" has always listed RDS clusters and keeps doing so.
+var normalizedTypeExtras = map[ResourceType][]TypeSelector{
+	ResourceTypeCluster: {{Type: ResourceTypeDatabase, NativeType: NativeTypeRDSCluster}},
+}
+
+// ResolveResourceType resolves a normalized type, native type or alias to
+// the resources it selects. Names are case-insensitive.
+func ResolveResourceType(name string) ([]TypeSelector, bool) {
+	name = strings.TrimSpace(name)
+
+	for native, resourceType := range nativeResourceTypes {
+		if strings.EqualFold(native, name) {
+			return []TypeSelector{{Type: resourceType, NativeType: native}}, true
+		}
+	}
+
+	if resourceType, ok := normalizedResourceType(name); ok {
+		return append([]TypeSelector{{Type: resourceType}}, normalizedTypeExtras[resourceType]...), true
+	}
+
+	selectors, ok := resourceTypeAliases[strings.ToLower(name)]
+	return selectors, ok
+}
+
+// normalizedResourceType returns the normalized type with the given name
+func normalizedResourceType(name string) (ResourceType, bool) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	for _, resourceType := range resourceTypes {
+		if string(resourceType) == name {
+			return resourceType, true
+		}
+	}
+	return ResourceTypeUnknown, false
+}
+
+// ResourceTypeNames returns every name accepted by ResolveResourceType, sorted
+func ResourceTypeNames() []string {
+	names := make([]string, 0, len(resourceTypes)+len(resourceTypeAliases)+len(nativeResourceTypes))
+	for _, resourceType := range resourceTypes {
+		names = append(names, string(resourceType))
+	}
+	for alias := range resourceTypeAliases {
+		names = append(names, alias)
+	}
+	for native := range nativeResourceTypes {
+		names = append(names, native)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// GetNativeResourceType returns the normalized type of a provider-native type
+func GetNativeResourceType(nativeType string) ResourceType {
+	if resourceType, ok := nativeResourceTypes[nativeType]; ok {
+		return resourceType
+	}
+	return ResourceTypeUnknown
+}
+
+// NormalizedType returns the resource's normalized type, resolving aliases
+// such as "ec2" that older providers and fixtures still emit
+func (r *Resource) NormalizedType() ResourceType {
+	if r.NativeType != "" {
+		if resourceType := GetNativeResourceType(r.NativeType); resourceType != ResourceTypeUnknown {
+			return resourceType
+		}
+	}
+	return GetResourceTypeFromString(r.Type)
+}
+
+// MatchesType reports whether the resource is selected by a normalized type,
+// native type or alias
+func (r *Resource) MatchesType(name string) bool {
+	if strings.EqualFold(strings.TrimSpace(name), r.Type) {
+		return true
+	}
+
+	selectors, ok := ResolveResourceType(name)
+	if !ok {
+		return false
+	}
+
+	resourceType, nativeType := r.NormalizedType(), r.nativeType()
+	for _, selector := range selectors {
+		if selector.Selects(resourceType, nativeType) {
+			return true
+		}
+	}
+	return false
+}
+
+// nativeType returns the resource's native type, inferring it from alias
+// types such as "rds_cluster" when it isn't set
+func (r *Resource) nativeType() string {
+	if r.NativeType != "" {
+		return r.NativeType
+	}
+	if selectors, ok := ResolveResourceType(r.Type); ok && len(selectors) == 1 {
+		return selectors[0].NativeType
+	}
+	return ""
+}
@@ -0,0 +1,88 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceMatchesType(t *testing.T) {
+	instance := &Resource{Type: "virtual_machine", NativeType: NativeTypeEC2Instance}
+	dbInstance := &Resource{Type: "database", NativeType: NativeTypeRDSInstance}
+	dbCluster := &Resource{Type: "database", NativeType: NativeTypeRDSCluster}
+	role := &Resource{Type: "role", NativeType: NativeTypeIAMRole}
+	function := &Resource{Type: "function", NativeType: NativeTypeLambdaFunction}
+	volume := &Resource{Type: "block_storage", NativeType: NativeTypeEBSVolume}
+	snapshot := &Resource{Type: "block_storage", NativeType: NativeTypeEBSSnapshot}
+	ecsService := &Resource{Type: "container", NativeType: NativeTypeECSService}
+
+	tests := []struct {
+		name      string
+		requested string
+		resource  *Resource
+		want      bool
+	}{
+		{"normalized type", "virtual_machine", instance, true},
+		{"alias", "ec2", instance, true},
+		{"case-insensitive alias", "EC2", instance, true},
+		{"native type", "aws::ec2::instance", instance, true},
+		{"other type", "s3", instance, false},
+		{"group alias", "rds", dbCluster, true},
+		{"native subtype alias", "rds_instance", dbInstance, true},
+		{"other native subtype", "rds_instance", dbCluster, false},
+		{"database", "database", dbCluster, true},
+		{"cluster includes rds clusters", "cluster", dbCluster, true},
+		{"cluster excludes rds instances", "cluster", dbInstance, false},
+		{"eks excludes rds clusters", "eks", dbCluster, false},
+		{"iam", "iam", role, true},
+		{"function alias", "lambda", function, true},
+		{"ebs volume", "ebs", volume, true},
+		{"ebs snapshot", "ebs", snapshot, true},
+		{"volume alias", "volume", snapshot, false},
+		{"block storage", "block_storage", snapshot, true},
+		{"load balancer alias", "alb", &Resource{Type: "load_balancer", NativeType: NativeTypeELBv2LoadBalancer}, true},
+		{"ecs service", "ecs", ecsService, true},
+		{"ecs subtype alias", "ecs_service", ecsService, true},
+		{"other ecs subtype", "ecs_task", ecsService, false},
+		{"container", "container", ecsService, true},
+		{"dynamodb table", "database", &Resource{Type: "database", NativeType: NativeTypeDynamoDBTable}, true},
+		{"dynamodb alias", "dynamodb", &Resource{Type: "database", NativeType: NativeTypeDynamoDBTable}, true},
+		{"rds excludes dynamodb", "rds", &Resource{Type: "database", NativeType: NativeTypeDynamoDBTable}, false},
+		{"unknown name", "lambdas", instance, false},
+
+		// Resources from older providers and fixtures carry alias types
+		{"alias-typed resource", "virtual_machine", &Resource{Type: "ec2"}, true},
+		{"alias-typed resource by alias", "instance", &Resource{Type: "ec2"}, true},
+		{"alias-typed subtype", "rds_cluster", &Resource{Type: "rds_instance"}, false},
+		{"unknown native type", "s3", &Resource{Type: "object_storage"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.resource.MatchesType(tt.requested))
+		})
+	}
+}
+
+func TestGetResourceTypeFromString(t *testing.T) {
+	assert.Equal(t, ResourceTypeVirtualMachine, GetResourceTypeFromString("ec2"))
+	assert.Equal(t, ResourceTypeDatabase, GetResourceTypeFromString("rds"))
+	assert.Equal(t, ResourceTypeDatabase, GetResourceTypeFromString(NativeTypeRDSCluster))
+	assert.Equal(t, ResourceTypeCluster, GetResourceTypeFromString("eks"))
+	assert.Equal(t, ResourceTypeCluster, GetResourceTypeFromString("cluster"))
+	assert.Equal(t, ResourceTypeUnknown, GetResourceTypeFromString("iam"))
+	assert.Equal(t, ResourceTypeUnknown, GetResourceTypeFromString("nope"))
+}
+
+func TestResourceTypeNames(t *testing.T) {
+	names := ResourceTypeNames()
+	assert.Contains(t, names, "virtual_machine")
+	assert.Contains(t, names, "rds_cluster")
+	assert.Contains(t, names, NativeTypeS3Bucket)
+	assert.IsIncreasing(t, names)
+
+	for _, name := range names {
+		_, ok := ResolveResourceType(name)
+		assert.True(t, ok, name)
+	}
+}
@@ -0,0 +1,150 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/Tsahi-Elkayam/cloudview/pkg/models"
+)
+
+// noneValue is displayed for fields a resource doesn't have
+const noneValue = "<none>"
+
+// CustomColumn is a named column whose value is read from a field path
+type CustomColumn struct {
+	Header string
+	Path   []string
+}
+
+// ParseCustomColumns parses a kubectl-style column specification such as
+// "ID:.id,TYPE:.metadata.instance_type,OWNER:.tags.Owner"
+func ParseCustomColumns(spec string) ([]CustomColumn, error) {
+	if strings.TrimSpace(spec) == "" {
+		return nil, fmt.Errorf("custom-columns format requires a column specification")
+	}
+
+	var columns []CustomColumn
+	for _, part := range strings.Split(spec, ",") {
+		header, path, ok := strings.Cut(strings.TrimSpace(part), ":")
+		if !ok || header == "" || path == "" {
+			return nil, fmt.Errorf("invalid custom column %q (expected HEADER:.field.path)", part)
+		}
+
+		segments, err := ParsePath(path)
+		if err != nil {
+			return nil, fmt.Errorf("invalid custom column %q: %w", part, err)
+		}
+
+		columns = append(columns, CustomColumn{Header: header, Path: segments})
+	}
+
+	return columns, nil
+}
+
+// ParsePath splits a field path such as ".metadata.security_groups[0]" into
+// its segments. The leading dot and surrounding {} used by JSONPath are optional.
+func ParsePath(path string) ([]string, error) {
+	path = strings.TrimSpace(path)
+	path = strings.TrimSuffix(strings.TrimPrefix(path, "{"), "}")
+	path = strings.TrimPrefix(path, ".")
+	if path == "" {
+		return nil, fmt.Errorf("empty field path")
+	}
+
+	var segments []string
+	for _, field := range strings.Split(path, ".") {
+		name, rest, _ := strings.Cut(field, "[")
+		if name == "" && rest == "" {
+			return nil, fmt.Errorf("empty segment in field path %q", path)
+		}
+		if name != "" {
+			segments = append(segments, name)
+		}
+
+		// Array indexes: name[0][1]
+		for rest != "" {
+			index, after, ok := strings.Cut(rest, "]")
+			if !ok {
+				return nil, fmt.Errorf("unterminated index in field path %q", path)
+			}
+			if _, err := strconv.Atoi(index); err != nil {
+				return nil, fmt.Errorf("invalid index %q in field path %q", index, path)
+			}
+			segments = append(segments, "["+index+"]")
+			rest = strings.TrimPrefix(after, "[")
+		}
+	}
+
+	return segments, nil
+}
+
+// Lookup resolves path segments against a generic JSON document
+func Lookup(document interface{}, path []string) (interface{}, bool) {
+	current := document
+	for _, segment := range path {
+		switch typed := current.(type) {
+		case map[string]interface{}:
+			value, ok := typed[segment]
+			if !ok {
+				return nil, false
+			}
+			current = value
+		case []interface{}:
+			if !strings.HasPrefix(segment, "[") {
+				return nil, false
+			}
+			index, err := strconv.Atoi(strings.Trim(segment, "[]"))
+			if err != nil {
+				return nil, false
+			}
+			if index < 0 {
+				index += len(typed)
+			}
+			if index < 0 || index >= len(typed) {
+				return nil, false
+			}
+			current = typed[index]
+		default:
+			return nil, false
+		}
+	}
+
+	return current, current != nil
+}
+
+// WriteCustomColumns writes resources as an aligned table of custom columns
+func WriteCustomColumns(w io.Writer, resources []models.Resource, columns []CustomColumn, header bool) error {
+	generic, err := Generic(resources)
+	if err != nil {
+		return err
+	}
+	items, _ := generic.([]interface{})
+
+	tw := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+
+	if header {
+		headers := make([]string, len(columns))
+		for i, column := range columns {
+			headers[i] = column.Header
+		}
+		fmt.Fprintln(tw, strings.Join(headers, "\t"))
+	}
+
+	for _, item := range items {
+		values := make([]string, len(columns))
+		for i, column := range columns {
+			values[i] = noneValue
+			if value, ok := Lookup(item, column.Path); ok {
+				if text := FormatValue(ScalarValue(value)); text != "" {
+					values[i] = text
+				}
+			}
+		}
+		fmt.Fprintln(tw, strings.Join(values, "\t"))
+	}
+
+	return tw.Flush()
+}
@@ -0,0 +1,51 @@
+package output
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCustomColumns(t *testing.T) {
+	columns, err := ParseCustomColumns("ID:.id,TYPE:.metadata.instance_type,SG:{.metadata.security_groups[0]}")
+	require.NoError(t, err)
+	require.Len(t, columns, 3)
+	assert.Equal(t, "TYPE", columns[1].Header)
+	assert.Equal(t, []string{"metadata", "instance_type"}, columns[1].Path)
+	assert.Equal(t, []string{"metadata", "security_groups", "[0]"}, columns[2].Path)
+
+	for _, spec := range []string{"", "ID", "ID:", ":.id", "SG:.groups[x]", "SG:.groups[0"} {
+		_, err := ParseCustomColumns(spec)
+		assert.Error(t, err, spec)
+	}
+}
+
+func TestWriteCustomColumns(t *testing.T) {
+	columns, err := ParseCustomColumns("ID:.id,TYPE:.metadata.instance_type,OWNER:.tags.Owner,CPU:.metadata.cpu_cores")
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteCustomColumns(&buf, testResources(), columns, true))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 3)
+	assert.Equal(t, []string{"ID", "TYPE", "OWNER", "CPU"}, strings.Fields(lines[0]))
+	assert.Equal(t, []string{"i-1234567890abcdef0", "t3.micro", "<none>", "2"}, strings.Fields(lines[1]))
+	assert.Equal(t, []string{"my-bucket", "<none>", "<none>", "<none>"}, strings.Fields(lines[2]))
+}
+
+func TestWriteTemplate(t *testing.T) {
+	tmpl, err := ParseTemplate(`{{range .resources}}{{.id}} {{.metadata.instance_type}} {{index .tags "Team"}}{{"\n"}}{{end}}`)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	document := map[string]interface{}{"resources": testResources()[:1]}
+	require.NoError(t, WriteTemplate(&buf, tmpl, document))
+	assert.Equal(t, "i-1234567890abcdef0 t3.micro web\n", buf.String())
+
+	_, err = ParseTemplate("{{.id")
+	assert.Error(t, err)
+}
@@ -0,0 +1,52 @@
+package output
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+
+	"github.com/Tsahi-Elkayam/cloudview/pkg/models"
+)
+
+// WriteCSV writes resources as comma-separated values with one column per
+// flattened field
+func WriteCSV(w io.Writer, resources []models.Resource, header bool) error {
+	return writeDelimited(w, resources, ',', header)
+}
+
+// WriteTSV writes resources as tab-separated values with one column per
+// flattened field
+func WriteTSV(w io.Writer, resources []models.Resource, header bool) error {
+	return writeDelimited(w, resources, '\t', header)
+}
+
+// writeDelimited writes flattened resources using the given field delimiter
+func writeDelimited(w io.Writer, resources []models.Resource, delimiter rune, header bool) error {
+	rows := make([]map[string]interface{}, 0, len(resources))
+	for _, resource := range resources {
+		rows = append(rows, FlattenResource(resource))
+	}
+	columns := ColumnsFor(rows)
+
+	writer := csv.NewWriter(w)
+	writer.Comma = delimiter
+
+	if header {
+		if err := writer.Write(columns); err != nil {
+			return fmt.Errorf("failed to write header: %w", err)
+		}
+	}
+
+	record := make([]string, len(columns))
+	for _, row := range rows {
+		for i, column := range columns {
+			record[i] = FormatValue(row[column])
+		}
+		if err := writer.Write(record); err != nil {
+			return fmt.Errorf("failed to write record: %w", err)
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
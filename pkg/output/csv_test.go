@@ -0,0 +1,51 @@
+package output
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteCSV(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteCSV(&buf, testResources(), true))
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+
+	header := records[0]
+	assert.Equal(t, "id", header[0])
+	assert.Contains(t, header, "tags.Team")
+	assert.Contains(t, header, "metadata.monitoring.enabled")
+
+	row := make(map[string]string)
+	for i, column := range header {
+		row[column] = records[1][i]
+	}
+	assert.Equal(t, "web", row["tags.Team"])
+	assert.Equal(t, "sg-1, sg-2", row["metadata.security_groups"])
+	assert.Equal(t, "2024-01-15T10:00:00Z", row["created_at"])
+
+	// Resources without a column leave it empty
+	assert.Len(t, records[2], len(header))
+}
+
+func TestWriteCSVStableColumns(t *testing.T) {
+	resources := testResources()
+
+	var first, second bytes.Buffer
+	require.NoError(t, WriteTSV(&first, resources, true))
+
+	// Reversing the input changes row order but not the columns
+	reversed := append(resources[1:], resources[0])
+	require.NoError(t, WriteTSV(&second, reversed, true))
+
+	firstHeader, _, _ := bytes.Cut(first.Bytes(), []byte("\n"))
+	secondHeader, _, _ := bytes.Cut(second.Bytes(), []byte("\n"))
+	assert.Equal(t, string(firstHeader), string(secondHeader))
+	assert.Contains(t, string(firstHeader), "\ttags.Environment\t")
+}
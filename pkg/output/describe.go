@@ -0,0 +1,365 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/Tsahi-Elkayam/cloudview/pkg/models"
+)
+
+// Metadata section titles, in display order
+const (
+	SectionNetwork       = "Network"
+	SectionSecurity      = "Security"
+	SectionStorage       = "Storage"
+	SectionConfiguration = "Configuration"
+)
+
+// sectionOrder lists the metadata sections in display order
+var sectionOrder = []string{SectionNetwork, SectionSecurity, SectionStorage, SectionConfiguration}
+
+// sectionIcons are printed before section titles
+var sectionIcons = map[string]string{
+	SectionNetwork:       "🌐",
+	SectionSecurity:      "🔒",
+	SectionStorage:       "💾",
+	SectionConfiguration: "⚙️ ",
+}
+
+// sectionKeywords assign metadata fields to sections by the words in their
+// names. Sections are tried in this order, so "storage_encrypted" is a
+// security setting rather than a storage one.
+var sectionKeywords = []struct {
+	section  string
+	keywords []string
+}{
+	{SectionSecurity, []string{"security", "groups", "rules", "ingress", "egress", "encryption", "encrypted", "sse", "kms", "key", "keys", "policy", "policies", "password", "publicly", "username", "role", "mfa"}},
+	{SectionNetwork, []string{"vpc", "subnet", "ip", "cidr", "endpoint", "port", "dns", "dhcp", "availability", "az", "address", "protocol"}},
+	{SectionStorage, []string{"storage", "allocated", "volume", "volumes", "versioning", "backup", "snapshot", "disk", "iops", "bucket"}},
+}
+
+// referenceFields are metadata fields that hold the IDs of other resources,
+// with the relation they describe
+var referenceFields = map[string]string{
+	"vpc_id":          "vpc",
+	"subnet_id":       "subnet",
+	"subnet_group":    "subnet group",
+	"security_groups": "security group",
+	"cluster_members": "cluster member",
+}
+
+// SectionField is a field shown in a description section
+type SectionField struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// DescribeSection is a titled group of metadata fields
+type DescribeSection struct {
+	Title  string         `json:"title"`
+	Fields []SectionField `json:"fields"`
+}
+
+// RelatedResource is a resource linked to a described resource through metadata
+type RelatedResource struct {
+	// Relation describes the link, e.g. "vpc", "security group" or
+	// "referenced by"
+	Relation string `json:"relation"`
+	// Field is the metadata field holding the reference
+	Field string `json:"field"`
+	ID    string `json:"id"`
+
+	// Type, Name and Region are set when the resource is in the inventory
+	Type   string `json:"type,omitempty"`
+	Name   string `json:"name,omitempty"`
+	Region string `json:"region,omitempty"`
+	Found  bool   `json:"found"`
+}
+
+// Description is the detailed view of a single resource
+type Description struct {
+	Resource    models.Resource        `json:"resource"`
+	LiveStatus  *models.ResourceStatus `json:"live_status,omitempty"`
+	StatusError string                 `json:"status_error,omitempty"`
+	Sections    []DescribeSection      `json:"sections"`
+	Related     []RelatedResource      `json:"related"`
+}
+
+// NewDescription describes a resource, finding related resources in inventory
+func NewDescription(resource models.Resource, inventory []models.Resource) Description {
+	return Description{
+		Resource: resource,
+		Sections: MetadataSections(resource),
+		Related:  FindRelatedResources(resource, inventory),
+	}
+}
+
+// MetadataSections groups a resource's flattened metadata into network,
+// security, storage and general configuration sections. Empty sections are
+// left out.
+func MetadataSections(resource models.Resource) []DescribeSection {
+	row := FlattenResource(resource)
+
+	var names []string
+	for column := range row {
+		if strings.HasPrefix(column, MetadataColumnPrefix) {
+			names = append(names, column)
+		}
+	}
+	sort.Strings(names)
+
+	fields := make(map[string][]SectionField)
+	for _, column := range names {
+		value := FormatValue(row[column])
+		if value == "" {
+			continue
+		}
+		name := strings.TrimPrefix(column, MetadataColumnPrefix)
+		section := metadataSection(name)
+		fields[section] = append(fields[section], SectionField{Name: name, Value: value})
+	}
+
+	var sections []DescribeSection
+	for _, title := range sectionOrder {
+		if len(fields[title]) > 0 {
+			sections = append(sections, DescribeSection{Title: title, Fields: fields[title]})
+		}
+	}
+	return sections
+}
+
+// metadataSection returns the section a metadata field belongs to
+func metadataSection(name string) string {
+	words := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return r == '_' || r == '.' || r == '-'
+	})
+
+	for _, candidate := range sectionKeywords {
+		for _, word := range words {
+			for _, keyword := range candidate.keywords {
+				if word == keyword {
+					return candidate.section
+				}
+			}
+		}
+	}
+	return SectionConfiguration
+}
+
+// FindRelatedResources lists the resources a resource references in its
+// metadata, such as its VPC, subnet and security groups, followed by the
+// resources in inventory whose metadata references it
+func FindRelatedResources(resource models.Resource, inventory []models.Resource) []RelatedResource {
+	byID := make(map[string]*models.Resource, len(inventory))
+	for i := range inventory {
+		byID[inventory[i].ID] = &inventory[i]
+		if inventory[i].ARN != "" {
+			byID[inventory[i].ARN] = &inventory[i]
+		}
+	}
+
+	var related []RelatedResource
+	seen := make(map[string]bool)
+	for _, ref := range metadataReferences(resource) {
+		target, found := byID[ref.value]
+		relation, known := referenceFields[ref.field]
+		if !known {
+			if !found {
+				continue
+			}
+			relation = target.Type
+		}
+		if found && isSameResource(target, &resource) {
+			continue
+		}
+
+		key := ref.field + "\x00" + ref.value
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		related = append(related, relatedResource(relation, ref.field, ref.value, target))
+	}
+
+	// Reverse references, e.g. the instances using a security group
+	for i := range inventory {
+		other := &inventory[i]
+		if isSameResource(other, &resource) {
+			continue
+		}
+		for _, ref := range metadataReferences(*other) {
+			if ref.value == resource.ID || (resource.ARN != "" && ref.value == resource.ARN) {
+				related = append(related, relatedResource("referenced by", ref.field, other.ID, other))
+				break
+			}
+		}
+	}
+
+	return related
+}
+
+// metadataReference is a single value found in a metadata field
+type metadataReference struct {
+	field string
+	value string
+}
+
+// metadataReferences returns the scalar values and list items of a
+// resource's flattened metadata
+func metadataReferences(resource models.Resource) []metadataReference {
+	row := FlattenResource(resource)
+
+	var columns []string
+	for column := range row {
+		if strings.HasPrefix(column, MetadataColumnPrefix) {
+			columns = append(columns, column)
+		}
+	}
+	sort.Strings(columns)
+
+	var refs []metadataReference
+	for _, column := range columns {
+		value, ok := row[column].(string)
+		if !ok || value == "" {
+			continue
+		}
+		field := strings.TrimPrefix(column, MetadataColumnPrefix)
+		for _, item := range strings.Split(value, ", ") {
+			if item != "" {
+				refs = append(refs, metadataReference{field: field, value: item})
+			}
+		}
+	}
+	return refs
+}
+
+// relatedResource builds a related resource entry, with details when the
+// resource was found in the inventory
+func relatedResource(relation, field, id string, target *models.Resource) RelatedResource {
+	related := RelatedResource{Relation: relation, Field: field, ID: id}
+	if target != nil {
+		related.ID = target.ID
+		related.Type = target.Type
+		related.Name = target.Name
+		related.Region = target.Region
+		related.Found = true
+	}
+	return related
+}
+
+// isSameResource reports whether two resources are the same resource
+func isSameResource(a, b *models.Resource) bool {
+	return a.Provider == b.Provider && a.Region == b.Region && a.Type == b.Type && a.ID == b.ID
+}
+
+// WriteDescription writes a sectioned, human-readable description
+func WriteDescription(w io.Writer, d Description) error {
+	resource := d.Resource
+
+	title := resource.ID
+	if resource.Name != "" && resource.Name != resource.ID {
+		title = fmt.Sprintf("%s (%s)", resource.ID, resource.Name)
+	}
+	fmt.Fprintf(w, "📋 %s\n\n", title)
+
+	resourceType := resource.Type
+	if resource.NativeType != "" {
+		resourceType = fmt.Sprintf("%s (%s)", resource.Type, resource.NativeType)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	writeField(tw, "Type", resourceType)
+	writeField(tw, "Provider", resource.Provider)
+	writeField(tw, "Region", resource.Region)
+	writeField(tw, "ARN", resource.ARN)
+	writeField(tw, "Created", FormatValue(resource.CreatedAt))
+	writeField(tw, "Updated", FormatValue(resource.UpdatedAt))
+	writeField(tw, "Status", formatStatus(resource.Status))
+	if d.LiveStatus != nil {
+		live := formatStatus(*d.LiveStatus)
+		if !d.LiveStatus.LastChecked.IsZero() {
+			live += ", checked " + d.LiveStatus.LastChecked.Local().Format(time.RFC3339)
+		}
+		writeField(tw, "Live status", live)
+	} else if d.StatusError != "" {
+		writeField(tw, "Live status", "unavailable: "+d.StatusError)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(w, "\n🏷️  Tags\n")
+	if len(resource.Tags) == 0 {
+		fmt.Fprintf(w, "   (none)\n")
+	} else {
+		keys := make([]string, 0, len(resource.Tags))
+		for key := range resource.Tags {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		tw = tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		for _, key := range keys {
+			fmt.Fprintf(tw, "   %s\t%s\n", key, resource.Tags[key])
+		}
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+	}
+
+	for _, section := range d.Sections {
+		fmt.Fprintf(w, "\n%s %s\n", sectionIcons[section.Title], section.Title)
+		tw = tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		for _, field := range section.Fields {
+			fmt.Fprintf(tw, "   %s\t%s\n", field.Name, field.Value)
+		}
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+	}
+
+	fmt.Fprintf(w, "\n🔗 Related resources\n")
+	if len(d.Related) == 0 {
+		fmt.Fprintf(w, "   (none found)\n")
+		return nil
+	}
+
+	tw = tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for _, related := range d.Related {
+		details := "(not in inventory)"
+		if related.Found {
+			details = strings.TrimSpace(fmt.Sprintf("%s %s %s", related.Type, related.Region, quoteName(related)))
+		}
+		fmt.Fprintf(tw, "   %s\t%s\t%s\tvia %s\n", related.Relation, related.ID, details, related.Field)
+	}
+	return tw.Flush()
+}
+
+// writeField writes a labelled overview field, skipping empty values
+func writeField(w io.Writer, label, value string) {
+	if value == "" {
+		return
+	}
+	fmt.Fprintf(w, "   %s:\t%s\n", label, value)
+}
+
+// formatStatus renders a status as "state (health)"
+func formatStatus(status models.ResourceStatus) string {
+	if status.Health == "" {
+		return status.State
+	}
+	return fmt.Sprintf("%s (%s)", status.State, status.Health)
+}
+
+// quoteName returns a related resource's name in quotes when it differs from its ID
+func quoteName(related RelatedResource) string {
+	if related.Name == "" || related.Name == related.ID {
+		return ""
+	}
+	return fmt.Sprintf("%q", related.Name)
+}
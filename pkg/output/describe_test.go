@@ -0,0 +1,118 @@
+package output
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Tsahi-Elkayam/cloudview/pkg/models"
+)
+
+func describeTestInventory() []models.Resource {
+	return []models.Resource{
+		{
+			ID:       "i-1",
+			Name:     "web",
+			Type:     "virtual_machine",
+			Provider: "aws",
+			Region:   "us-east-1",
+			Status:   models.ResourceStatus{State: "running", Health: "healthy"},
+			Tags:     map[string]string{"Team": "web"},
+			Metadata: map[string]interface{}{
+				"vpc_id":          "vpc-1",
+				"subnet_id":       "subnet-1",
+				"security_groups": []string{"sg-1", "sg-2"},
+				"private_ip":      "10.0.1.25",
+				"instance_type":   "t3.micro",
+				"key_name":        "ops",
+			},
+		},
+		{ID: "vpc-1", Name: "main", Type: "vpc", Provider: "aws", Region: "us-east-1"},
+		{ID: "sg-1", Name: "web-sg", Type: "security_group", Provider: "aws", Region: "us-east-1",
+			Metadata: map[string]interface{}{"vpc_id": "vpc-1"}},
+		{ID: "db-1", Name: "orders", Type: "database", Provider: "aws", Region: "us-east-1",
+			Metadata: map[string]interface{}{"security_groups": []string{"sg-1"}, "storage_encrypted": true, "allocated_storage": 20}},
+	}
+}
+
+func TestMetadataSections(t *testing.T) {
+	sections := MetadataSections(describeTestInventory()[0])
+
+	titles := make([]string, len(sections))
+	byTitle := make(map[string][]string)
+	for i, section := range sections {
+		titles[i] = section.Title
+		for _, field := range section.Fields {
+			byTitle[section.Title] = append(byTitle[section.Title], field.Name)
+		}
+	}
+
+	assert.Equal(t, []string{SectionNetwork, SectionSecurity, SectionConfiguration}, titles)
+	assert.Equal(t, []string{"private_ip", "subnet_id", "vpc_id"}, byTitle[SectionNetwork])
+	assert.Equal(t, []string{"key_name", "security_groups"}, byTitle[SectionSecurity])
+	assert.Equal(t, []string{"instance_type"}, byTitle[SectionConfiguration])
+
+	database := MetadataSections(describeTestInventory()[3])
+	require.Len(t, database, 2)
+	assert.Equal(t, SectionSecurity, database[0].Title)
+	assert.Equal(t, SectionStorage, database[1].Title)
+	assert.Equal(t, "allocated_storage", database[1].Fields[0].Name)
+}
+
+func TestFindRelatedResources(t *testing.T) {
+	inventory := describeTestInventory()
+
+	tests := []struct {
+		name     string
+		resource models.Resource
+		expected []RelatedResource
+	}{
+		{
+			name:     "instance references",
+			resource: inventory[0],
+			expected: []RelatedResource{
+				{Relation: "security group", Field: "security_groups", ID: "sg-1", Type: "security_group", Name: "web-sg", Region: "us-east-1", Found: true},
+				{Relation: "security group", Field: "security_groups", ID: "sg-2"},
+				{Relation: "subnet", Field: "subnet_id", ID: "subnet-1"},
+				{Relation: "vpc", Field: "vpc_id", ID: "vpc-1", Type: "vpc", Name: "main", Region: "us-east-1", Found: true},
+			},
+		},
+		{
+			name:     "security group is referenced by instance and database",
+			resource: inventory[2],
+			expected: []RelatedResource{
+				{Relation: "vpc", Field: "vpc_id", ID: "vpc-1", Type: "vpc", Name: "main", Region: "us-east-1", Found: true},
+				{Relation: "referenced by", Field: "security_groups", ID: "i-1", Type: "virtual_machine", Name: "web", Region: "us-east-1", Found: true},
+				{Relation: "referenced by", Field: "security_groups", ID: "db-1", Type: "database", Name: "orders", Region: "us-east-1", Found: true},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, FindRelatedResources(tt.resource, inventory))
+		})
+	}
+}
+
+func TestWriteDescription(t *testing.T) {
+	inventory := describeTestInventory()
+	description := NewDescription(inventory[0], inventory)
+	description.StatusError = "access denied"
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteDescription(&buf, description))
+
+	text := buf.String()
+	assert.Contains(t, text, "📋 i-1 (web)")
+	assert.Contains(t, text, "running (healthy)")
+	assert.Contains(t, text, "unavailable: access denied")
+	assert.Contains(t, text, "🌐 Network")
+	assert.Contains(t, text, "10.0.1.25")
+	assert.Contains(t, text, "🔒 Security")
+	assert.NotContains(t, text, "💾 Storage")
+	assert.Contains(t, text, "(not in inventory)")
+	assert.Contains(t, text, `vpc us-east-1 "main"`)
+}
@@ -0,0 +1,295 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/xuri/excelize/v2"
+
+	"github.com/Tsahi-Elkayam/cloudview/pkg/models"
+)
+
+// summarySheet is the name of the overview sheet in Excel workbooks
+const summarySheet = "Summary"
+
+// excelMaxColumnWidth caps auto-sized column widths
+const excelMaxColumnWidth = 60
+
+// sheetNames maps resource types to the workbook sheet they are listed on
+var sheetNames = map[string]string{
+	"virtual_machine": "EC2",
+	"ec2":             "EC2",
+	"object_storage":  "S3",
+	"s3":              "S3",
+	"database":        "RDS",
+	"user":            "IAM",
+	"role":            "IAM",
+	"policy":          "IAM",
+	"vpc":             "VPC",
+	"security_group":  "SG",
+	"function":        "Lambda",
+	"block_storage":   "EBS",
+	"load_balancer":   "ELB",
+	"container":       "ECS",
+}
+
+// nativeSheetNames lists native types listed on their own sheet instead of
+// sharing their resource type's, so their columns don't mix
+var nativeSheetNames = map[string]string{
+	models.NativeTypeDynamoDBTable: "DynamoDB",
+}
+
+// ResourceSheetName returns the workbook sheet name for a resource
+func ResourceSheetName(resource models.Resource) string {
+	if name, ok := nativeSheetNames[resource.NativeType]; ok {
+		return name
+	}
+	return SheetName(resource.Type)
+}
+
+// SheetName returns the workbook sheet name for a resource type
+func SheetName(resourceType string) string {
+	if name, ok := sheetNames[resourceType]; ok {
+		return name
+	}
+
+	// Excel sheet names are limited to 31 characters and can't contain []:*?/\
+	name := strings.Map(func(r rune) rune {
+		if strings.ContainsRune(`[]:*?/\`, r) {
+			return '_'
+		}
+		return r
+	}, resourceType)
+	if name == "" {
+		name = "Other"
+	}
+	if len(name) > 31 {
+		name = name[:31]
+	}
+	return name
+}
+
+// WriteExcel writes resources as an xlsx workbook with a summary sheet
+// followed by one sheet per resource type
+func WriteExcel(w io.Writer, resources []models.Resource) error {
+	f := excelize.NewFile()
+	defer f.Close()
+
+	headerStyle, err := f.NewStyle(&excelize.Style{
+		Font: &excelize.Font{Bold: true, Color: "FFFFFF"},
+		Fill: excelize.Fill{Type: "pattern", Pattern: 1, Color: []string{"305496"}},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create header style: %w", err)
+	}
+
+	dateStyle, err := f.NewStyle(&excelize.Style{NumFmt: 22})
+	if err != nil {
+		return fmt.Errorf("failed to create date style: %w", err)
+	}
+
+	// The default sheet becomes the summary
+	if err := f.SetSheetName(f.GetSheetName(0), summarySheet); err != nil {
+		return fmt.Errorf("failed to create summary sheet: %w", err)
+	}
+	if err := writeExcelSummary(f, resources, headerStyle); err != nil {
+		return err
+	}
+
+	// Group resources by sheet
+	groups := make(map[string][]models.Resource)
+	for _, resource := range resources {
+		name := ResourceSheetName(resource)
+		groups[name] = append(groups[name], resource)
+	}
+
+	names := make([]string, 0, len(groups))
+	for name := range groups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if err := writeExcelResourceSheet(f, name, groups[name], headerStyle, dateStyle); err != nil {
+			return err
+		}
+	}
+
+	f.SetActiveSheet(0)
+
+	if _, err := f.WriteTo(w); err != nil {
+		return fmt.Errorf("failed to write workbook: %w", err)
+	}
+
+	return nil
+}
+
+// writeExcelResourceSheet writes one sheet of flattened resources
+func writeExcelResourceSheet(f *excelize.File, name string, resources []models.Resource, headerStyle, dateStyle int) error {
+	if _, err := f.NewSheet(name); err != nil {
+		return fmt.Errorf("failed to create sheet %s: %w", name, err)
+	}
+
+	rows := make([]map[string]interface{}, 0, len(resources))
+	for _, resource := range resources {
+		rows = append(rows, FlattenResource(resource))
+	}
+	columns := ColumnsFor(rows)
+
+	widths := make([]int, len(columns))
+	for i, column := range columns {
+		cell, _ := excelize.CoordinatesToCellName(i+1, 1)
+		if err := f.SetCellValue(name, cell, column); err != nil {
+			return fmt.Errorf("failed to write header on sheet %s: %w", name, err)
+		}
+		widths[i] = len(column)
+	}
+
+	for r, row := range rows {
+		for c, column := range columns {
+			value, ok := row[column]
+			if !ok || value == nil {
+				continue
+			}
+
+			cell, _ := excelize.CoordinatesToCellName(c+1, r+2)
+			if t, isTime := value.(time.Time); isTime {
+				if t.IsZero() {
+					continue
+				}
+				value = t.UTC()
+				f.SetCellStyle(name, cell, cell, dateStyle)
+				widths[c] = maxInt(widths[c], 19)
+			} else {
+				widths[c] = maxInt(widths[c], len(FormatValue(value)))
+			}
+
+			if err := f.SetCellValue(name, cell, value); err != nil {
+				return fmt.Errorf("failed to write %s on sheet %s: %w", column, name, err)
+			}
+		}
+	}
+
+	return formatExcelTable(f, name, len(columns), len(rows), widths, headerStyle)
+}
+
+// writeExcelSummary writes resource counts by region, type and state
+func writeExcelSummary(f *excelize.File, resources []models.Resource, headerStyle int) error {
+	f.SetCellValue(summarySheet, "A1", "CloudView Inventory")
+	f.SetCellValue(summarySheet, "A2", "Generated")
+	f.SetCellValue(summarySheet, "B2", time.Now().UTC().Format(time.RFC3339))
+	f.SetCellValue(summarySheet, "A3", "Total resources")
+	f.SetCellValue(summarySheet, "B3", len(resources))
+
+	sections := []struct {
+		title string
+		key   func(models.Resource) string
+	}{
+		{"Region", func(r models.Resource) string { return r.Region }},
+		{"Type", func(r models.Resource) string { return r.Type }},
+		{"State", func(r models.Resource) string { return r.Status.State }},
+	}
+
+	// Each breakdown gets its own pair of columns, separated by a blank column
+	for i, section := range sections {
+		col := i*3 + 1
+		header, _ := excelize.CoordinatesToCellName(col, 5)
+		countHeader, _ := excelize.CoordinatesToCellName(col+1, 5)
+		f.SetCellValue(summarySheet, header, section.title)
+		f.SetCellValue(summarySheet, countHeader, "Count")
+		f.SetCellStyle(summarySheet, header, countHeader, headerStyle)
+
+		for row, count := range CountBy(resources, section.key) {
+			keyCell, _ := excelize.CoordinatesToCellName(col, row+6)
+			valueCell, _ := excelize.CoordinatesToCellName(col+1, row+6)
+			f.SetCellValue(summarySheet, keyCell, count.Key)
+			f.SetCellValue(summarySheet, valueCell, count.Count)
+		}
+
+		colName, _ := excelize.ColumnNumberToName(col)
+		if err := f.SetColWidth(summarySheet, colName, colName, 20); err != nil {
+			return fmt.Errorf("failed to format summary sheet: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// formatExcelTable styles the header row, freezes it and enables filtering
+func formatExcelTable(f *excelize.File, sheet string, columns, rows int, widths []int, headerStyle int) error {
+	lastHeader, _ := excelize.CoordinatesToCellName(columns, 1)
+	if err := f.SetCellStyle(sheet, "A1", lastHeader, headerStyle); err != nil {
+		return fmt.Errorf("failed to style sheet %s: %w", sheet, err)
+	}
+
+	if err := f.SetPanes(sheet, &excelize.Panes{
+		Freeze:      true,
+		YSplit:      1,
+		TopLeftCell: "A2",
+		ActivePane:  "bottomLeft",
+	}); err != nil {
+		return fmt.Errorf("failed to freeze header on sheet %s: %w", sheet, err)
+	}
+
+	lastCell, _ := excelize.CoordinatesToCellName(columns, maxInt(rows+1, 1))
+	if err := f.AutoFilter(sheet, "A1:"+lastCell, nil); err != nil {
+		return fmt.Errorf("failed to add filter on sheet %s: %w", sheet, err)
+	}
+
+	for i, width := range widths {
+		colName, _ := excelize.ColumnNumberToName(i + 1)
+		f.SetColWidth(sheet, colName, colName, float64(minInt(width+2, excelMaxColumnWidth)))
+	}
+
+	return nil
+}
+
+// KeyCount is a value and the number of resources that have it
+type KeyCount struct {
+	Key   string
+	Count int
+}
+
+// CountBy counts resources by the given key, sorted by descending count then key
+func CountBy(resources []models.Resource, key func(models.Resource) string) []KeyCount {
+	counts := make(map[string]int)
+	for _, resource := range resources {
+		k := key(resource)
+		if k == "" {
+			k = "(none)"
+		}
+		counts[k]++
+	}
+
+	result := make([]KeyCount, 0, len(counts))
+	for k, count := range counts {
+		result = append(result, KeyCount{Key: k, Count: count})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Count != result[j].Count {
+			return result[i].Count > result[j].Count
+		}
+		return result[i].Key < result[j].Key
+	})
+
+	return result
+}
+
+// Helper functions for min/max
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,99 @@
+package output
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xuri/excelize/v2"
+
+	"github.com/Tsahi-Elkayam/cloudview/pkg/models"
+)
+
+func testResources() []models.Resource {
+	launched := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	return []models.Resource{
+		{
+			ID:        "i-1234567890abcdef0",
+			Name:      "web-server",
+			Type:      "virtual_machine",
+			Provider:  "aws",
+			Region:    "us-east-1",
+			Status:    models.ResourceStatus{State: "running"},
+			CreatedAt: launched,
+			Tags:      map[string]string{"Environment": "prod", "Team": "web"},
+			Metadata: map[string]interface{}{
+				"instance_type":   "t3.micro",
+				"cpu_cores":       int32(2),
+				"security_groups": []string{"sg-1", "sg-2"},
+				"monitoring":      map[string]interface{}{"enabled": true},
+			},
+		},
+		{
+			ID:       "my-bucket",
+			Name:     "my-bucket",
+			Type:     "object_storage",
+			Provider: "aws",
+			Region:   "us-west-2",
+			Status:   models.ResourceStatus{State: "available"},
+		},
+	}
+}
+
+func TestFlattenResource(t *testing.T) {
+	row := FlattenResource(testResources()[0])
+
+	assert.Equal(t, "i-1234567890abcdef0", row["id"])
+	assert.Equal(t, "running", row["status.state"])
+	assert.Equal(t, "prod", row["tags.Environment"])
+	assert.Equal(t, "web", row["tags.Team"])
+	assert.Equal(t, "t3.micro", row["metadata.instance_type"])
+	assert.Equal(t, int64(2), row["metadata.cpu_cores"])
+	assert.Equal(t, "sg-1, sg-2", row["metadata.security_groups"])
+	assert.Equal(t, true, row["metadata.monitoring.enabled"])
+
+	columns := ColumnsFor([]map[string]interface{}{row})
+	assert.Equal(t, BaseColumns, columns[:len(BaseColumns)])
+	assert.Equal(t, []string{"tags.Environment", "tags.Team", "metadata.cpu_cores"}, columns[len(BaseColumns):len(BaseColumns)+3])
+}
+
+func TestResourceSheetName(t *testing.T) {
+	assert.Equal(t, "RDS", ResourceSheetName(models.Resource{Type: "database", NativeType: models.NativeTypeRDSInstance}))
+	assert.Equal(t, "DynamoDB", ResourceSheetName(models.Resource{Type: "database", NativeType: models.NativeTypeDynamoDBTable}))
+	assert.Equal(t, "EC2", ResourceSheetName(models.Resource{Type: "virtual_machine"}))
+}
+
+func TestWriteExcel(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteExcel(&buf, testResources()))
+
+	f, err := excelize.OpenReader(&buf)
+	require.NoError(t, err)
+	defer f.Close()
+
+	assert.Equal(t, []string{"Summary", "EC2", "S3"}, f.GetSheetList())
+
+	total, err := f.GetCellValue("Summary", "B3")
+	require.NoError(t, err)
+	assert.Equal(t, "2", total)
+
+	rows, err := f.GetRows("EC2")
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	assert.Equal(t, "id", rows[0][0])
+	assert.Equal(t, "i-1234567890abcdef0", rows[1][0])
+	assert.Contains(t, rows[0], "metadata.instance_type")
+
+	// Numbers are stored as numbers, not text
+	columns := rows[0]
+	for i, column := range columns {
+		if column == "metadata.cpu_cores" {
+			cell, _ := excelize.CoordinatesToCellName(i+1, 2)
+			cellType, err := f.GetCellType("EC2", cell)
+			require.NoError(t, err)
+			assert.NotEqual(t, excelize.CellTypeSharedString, cellType)
+		}
+	}
+}
@@ -0,0 +1,215 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Tsahi-Elkayam/cloudview/pkg/models"
+)
+
+// BaseColumns are the resource fields that always lead a flattened row
+var BaseColumns = []string{
+	"id", "name", "type", "native_type", "arn", "provider", "region",
+	"status.state", "status.health", "created_at", "updated_at",
+}
+
+// Column prefixes for flattened tags and metadata
+const (
+	TagColumnPrefix      = "tags."
+	MetadataColumnPrefix = "metadata."
+)
+
+// FlattenResource flattens a resource into a map of dotted column names to values.
+// Every tag becomes a "tags.<key>" column and metadata becomes "metadata.<path>"
+// columns. Scalar values keep their Go type (numbers, booleans, times) so typed
+// writers such as Excel can use them; nested maps become dotted columns.
+func FlattenResource(resource models.Resource) map[string]interface{} {
+	row := map[string]interface{}{
+		"id":            resource.ID,
+		"name":          resource.Name,
+		"type":          resource.Type,
+		"native_type":   resource.NativeType,
+		"arn":           resource.ARN,
+		"provider":      resource.Provider,
+		"region":        resource.Region,
+		"status.state":  resource.Status.State,
+		"status.health": resource.Status.Health,
+		"created_at":    resource.CreatedAt,
+		"updated_at":    resource.UpdatedAt,
+	}
+
+	for key, value := range resource.Tags {
+		row[TagColumnPrefix+key] = value
+	}
+
+	for key, value := range resource.Metadata {
+		flattenValue(row, MetadataColumnPrefix+key, value)
+	}
+
+	return row
+}
+
+// flattenValue adds value to row under prefix, expanding nested maps
+func flattenValue(row map[string]interface{}, prefix string, value interface{}) {
+	v := reflect.ValueOf(value)
+	for v.IsValid() && (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			row[prefix] = nil
+			return
+		}
+		v = v.Elem()
+	}
+
+	if !v.IsValid() {
+		row[prefix] = nil
+		return
+	}
+
+	if v.Kind() == reflect.Map && v.Type().Key().Kind() == reflect.String {
+		if v.Len() == 0 {
+			row[prefix] = nil
+			return
+		}
+		for _, key := range v.MapKeys() {
+			flattenValue(row, prefix+"."+key.String(), v.MapIndex(key).Interface())
+		}
+		return
+	}
+
+	row[prefix] = ScalarValue(v.Interface())
+}
+
+// ScalarValue converts a metadata value into a single cell value.
+// Pointers are dereferenced, slices of scalars are joined with ", " and
+// anything more complex is rendered as compact JSON with sorted keys.
+func ScalarValue(value interface{}) interface{} {
+	v := reflect.ValueOf(value)
+	for v.IsValid() && (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+
+	if !v.IsValid() {
+		return nil
+	}
+
+	switch typed := v.Interface().(type) {
+	case time.Time:
+		return typed
+	case fmt.Stringer:
+		if v.Kind() != reflect.Struct {
+			return typed.String()
+		}
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String()
+	case reflect.Bool:
+		return v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return v.Uint()
+	case reflect.Float32, reflect.Float64:
+		return v.Float()
+	case reflect.Slice, reflect.Array:
+		if v.Len() == 0 {
+			return ""
+		}
+		parts := make([]string, 0, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			item := ScalarValue(v.Index(i).Interface())
+			switch item.(type) {
+			case string, bool, int64, uint64, float64, nil:
+				parts = append(parts, FormatValue(item))
+			default:
+				return marshalValue(v.Interface())
+			}
+		}
+		return strings.Join(parts, ", ")
+	default:
+		return marshalValue(v.Interface())
+	}
+}
+
+// FormatValue renders a flattened value as text
+func FormatValue(value interface{}) string {
+	switch typed := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return typed
+	case time.Time:
+		if typed.IsZero() {
+			return ""
+		}
+		return typed.UTC().Format(time.RFC3339)
+	case float64:
+		return strconv.FormatFloat(typed, 'f', -1, 64)
+	default:
+		return fmt.Sprintf("%v", typed)
+	}
+}
+
+// marshalValue renders a complex value as compact JSON
+func marshalValue(value interface{}) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(data)
+}
+
+// ColumnsFor returns the union of flattened columns across rows in a stable
+// order: the base columns first, then tag columns, then metadata columns, each
+// group sorted by name. The order only depends on which columns are present,
+// so output from successive runs diffs cleanly.
+func ColumnsFor(rows []map[string]interface{}) []string {
+	base := make(map[string]bool, len(BaseColumns))
+	for _, column := range BaseColumns {
+		base[column] = true
+	}
+
+	extra := make(map[string]bool)
+	for _, row := range rows {
+		for column := range row {
+			if !base[column] {
+				extra[column] = true
+			}
+		}
+	}
+
+	others := make([]string, 0, len(extra))
+	for column := range extra {
+		others = append(others, column)
+	}
+	sort.Slice(others, func(i, j int) bool {
+		gi, gj := columnGroup(others[i]), columnGroup(others[j])
+		if gi != gj {
+			return gi < gj
+		}
+		return others[i] < others[j]
+	})
+
+	return append(append([]string(nil), BaseColumns...), others...)
+}
+
+// columnGroup orders tag columns before metadata columns
+func columnGroup(column string) int {
+	switch {
+	case strings.HasPrefix(column, TagColumnPrefix):
+		return 0
+	case strings.HasPrefix(column, MetadataColumnPrefix):
+		return 1
+	default:
+		return 2
+	}
+}
@@ -0,0 +1,133 @@
+package output
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/Tsahi-Elkayam/cloudview/pkg/models"
+)
+
+//go:embed templates/report.html
+var templateFS embed.FS
+
+// reportTemplate is the self-contained HTML report page
+var reportTemplate = template.Must(template.ParseFS(templateFS, "templates/report.html"))
+
+// htmlReport is the data rendered by the HTML report template
+type htmlReport struct {
+	Title     string
+	Generated string
+	Total     int
+	Types     []KeyCount
+	Charts    []htmlChart
+	Rows      []htmlRow
+}
+
+// htmlChart is a bar chart of resource counts
+type htmlChart struct {
+	Title string
+	Bars  []htmlBar
+}
+
+// htmlBar is a single bar in a chart
+type htmlBar struct {
+	Label   string
+	Count   int
+	Percent float64
+}
+
+// htmlRow is a resource as displayed in the report table
+type htmlRow struct {
+	ID       string
+	Name     string
+	Type     string
+	Provider string
+	Region   string
+	State    string
+	Created  string
+	Tags     []string
+	Metadata string
+}
+
+// WriteHTML writes resources as a single-file HTML report with embedded
+// styles and scripts, so it can be opened offline or attached to an email
+func WriteHTML(w io.Writer, resources []models.Resource) error {
+	report := htmlReport{
+		Title:     "CloudView Inventory",
+		Generated: time.Now().UTC().Format("2006-01-02 15:04 MST"),
+		Total:     len(resources),
+		Types:     CountBy(resources, func(r models.Resource) string { return r.Type }),
+		Charts: []htmlChart{
+			newHTMLChart("Region", resources, func(r models.Resource) string { return r.Region }),
+			newHTMLChart("Type", resources, func(r models.Resource) string { return r.Type }),
+			newHTMLChart("State", resources, func(r models.Resource) string { return r.Status.State }),
+		},
+	}
+
+	// Tabs are listed alphabetically
+	sort.Slice(report.Types, func(i, j int) bool { return report.Types[i].Key < report.Types[j].Key })
+
+	for _, resource := range resources {
+		row := htmlRow{
+			ID:       resource.ID,
+			Name:     resource.Name,
+			Type:     resource.Type,
+			Provider: resource.Provider,
+			Region:   resource.Region,
+			State:    resource.Status.State,
+		}
+
+		if !resource.CreatedAt.IsZero() {
+			row.Created = resource.CreatedAt.UTC().Format("2006-01-02 15:04")
+		}
+
+		for key, value := range resource.Tags {
+			row.Tags = append(row.Tags, key+"="+value)
+		}
+		sort.Strings(row.Tags)
+
+		if len(resource.Metadata) > 0 {
+			data, err := json.MarshalIndent(resource.Metadata, "", "  ")
+			if err != nil {
+				return fmt.Errorf("failed to encode metadata for %s: %w", resource.ID, err)
+			}
+			row.Metadata = string(data)
+		}
+
+		report.Rows = append(report.Rows, row)
+	}
+
+	if err := reportTemplate.Execute(w, report); err != nil {
+		return fmt.Errorf("failed to render HTML report: %w", err)
+	}
+
+	return nil
+}
+
+// newHTMLChart builds a bar chart of resource counts by key
+func newHTMLChart(title string, resources []models.Resource, key func(models.Resource) string) htmlChart {
+	chart := htmlChart{Title: title}
+
+	counts := CountBy(resources, key)
+	if len(counts) == 0 {
+		return chart
+	}
+
+	// Bars are scaled relative to the largest count
+	max := counts[0].Count
+	for _, count := range counts {
+		chart.Bars = append(chart.Bars, htmlBar{
+			Label:   count.Key,
+			Count:   count.Count,
+			Percent: math.Round(float64(count.Count)/float64(max)*1000) / 10,
+		})
+	}
+
+	return chart
+}
@@ -0,0 +1,36 @@
+package output
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Tsahi-Elkayam/cloudview/pkg/models"
+)
+
+func TestWriteHTML(t *testing.T) {
+	resources := append(testResources(), models.Resource{
+		ID:   "<script>alert(1)</script>",
+		Type: "vpc",
+	})
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteHTML(&buf, resources))
+	html := buf.String()
+
+	assert.Contains(t, html, "<title>CloudView Inventory</title>")
+	assert.Contains(t, html, "i-1234567890abcdef0")
+	assert.Contains(t, html, `data-type="object_storage"`)
+	assert.Contains(t, html, "Environment=prod")
+	assert.Contains(t, html, "&#34;instance_type&#34;: &#34;t3.micro&#34;")
+	assert.Contains(t, html, "width: 100%")
+
+	// Resource values are escaped
+	assert.NotContains(t, html, "<script>alert(1)</script>")
+
+	// Nothing is loaded from the network
+	assert.NotContains(t, html, "http://")
+	assert.NotContains(t, html, "https://")
+}
@@ -0,0 +1,35 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/Tsahi-Elkayam/cloudview/pkg/models"
+)
+
+// JSONLWriter writes resources as JSON Lines: one compact JSON object per
+// line, written as soon as each resource is passed in
+type JSONLWriter struct {
+	encoder *json.Encoder
+	count   int
+}
+
+// NewJSONLWriter creates a JSON Lines writer
+func NewJSONLWriter(w io.Writer) *JSONLWriter {
+	return &JSONLWriter{encoder: json.NewEncoder(w)}
+}
+
+// Write writes a resource as a single line
+func (w *JSONLWriter) Write(resource models.Resource) error {
+	if err := w.encoder.Encode(resource); err != nil {
+		return fmt.Errorf("failed to write resource %s: %w", resource.ID, err)
+	}
+	w.count++
+	return nil
+}
+
+// Count returns the number of resources written
+func (w *JSONLWriter) Count() int {
+	return w.count
+}
@@ -0,0 +1,40 @@
+package output
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Tsahi-Elkayam/cloudview/pkg/models"
+)
+
+func TestJSONLWriter(t *testing.T) {
+	var buf bytes.Buffer
+	writer := NewJSONLWriter(&buf)
+
+	resources := testResources()
+	for i, resource := range resources {
+		require.NoError(t, writer.Write(resource))
+
+		// Each resource is written out immediately
+		assert.Equal(t, i+1, bytes.Count(buf.Bytes(), []byte("\n")))
+	}
+	assert.Equal(t, len(resources), writer.Count())
+
+	scanner := bufio.NewScanner(&buf)
+	var decoded []models.Resource
+	for scanner.Scan() {
+		var resource models.Resource
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &resource))
+		decoded = append(decoded, resource)
+	}
+	require.NoError(t, scanner.Err())
+
+	require.Len(t, decoded, len(resources))
+	assert.Equal(t, resources[0].ID, decoded[0].ID)
+	assert.Equal(t, resources[0].Tags, decoded[0].Tags)
+}
@@ -0,0 +1,123 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Tsahi-Elkayam/cloudview/pkg/models"
+)
+
+// MarkdownOptions controls markdown rendering
+type MarkdownOptions struct {
+	// Details adds a collapsible <details> block with each resource's metadata
+	Details bool
+}
+
+// markdownEscaper escapes characters that break GitHub-flavored table cells
+var markdownEscaper = strings.NewReplacer("|", `\|`, "\r\n", "<br>", "\n", "<br>")
+
+// WriteMarkdown writes resources as GitHub-flavored markdown: a summary
+// header followed by one table per resource type
+func WriteMarkdown(w io.Writer, resources []models.Resource, opts MarkdownOptions) error {
+	groups := GroupResources(resources, "type")
+
+	var b strings.Builder
+	b.WriteString("# CloudView Inventory\n\n")
+	fmt.Fprintf(&b, "**%d resources** across %d types in %d regions · generated %s\n\n",
+		len(resources), len(groups),
+		len(CountBy(resources, func(r models.Resource) string { return r.Region })),
+		time.Now().UTC().Format("2006-01-02 15:04 MST"))
+
+	if len(groups) > 0 {
+		b.WriteString("| Type | Count |\n|------|------:|\n")
+		for _, group := range groups {
+			fmt.Fprintf(&b, "| %s | %d |\n", markdownCell(group.Key), len(group.Resources))
+		}
+	}
+
+	for _, group := range groups {
+		fmt.Fprintf(&b, "\n## %s (%d)\n\n", group.Key, len(group.Resources))
+		b.WriteString("| ID | Name | Region | Status | Created | Tags |\n")
+		b.WriteString("|----|------|--------|--------|---------|------|\n")
+
+		for _, resource := range group.Resources {
+			created := ""
+			if !resource.CreatedAt.IsZero() {
+				created = resource.CreatedAt.UTC().Format("2006-01-02")
+			}
+
+			fmt.Fprintf(&b, "| %s | %s | %s | %s | %s | %s |\n",
+				markdownCode(resource.ID),
+				markdownCell(resource.Name),
+				markdownCell(resource.Region),
+				markdownCell(resource.Status.State),
+				created,
+				markdownTags(resource.Tags))
+		}
+
+		if opts.Details {
+			for _, resource := range group.Resources {
+				writeMarkdownDetails(&b, resource)
+			}
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// writeMarkdownDetails writes a collapsible block with a resource's metadata
+func writeMarkdownDetails(b *strings.Builder, resource models.Resource) {
+	if len(resource.Metadata) == 0 {
+		return
+	}
+
+	row := FlattenResource(resource)
+	var keys []string
+	for key := range row {
+		if strings.HasPrefix(key, MetadataColumnPrefix) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	summary := resource.ID
+	if resource.Name != "" && resource.Name != resource.ID {
+		summary += " (" + resource.Name + ")"
+	}
+
+	fmt.Fprintf(b, "\n<details>\n<summary>%s</summary>\n\n", markdownCell(summary))
+	b.WriteString("| Field | Value |\n|-------|-------|\n")
+	for _, key := range keys {
+		fmt.Fprintf(b, "| %s | %s |\n",
+			markdownCell(strings.TrimPrefix(key, MetadataColumnPrefix)),
+			markdownCell(FormatValue(row[key])))
+	}
+	b.WriteString("\n</details>\n")
+}
+
+// markdownTags renders tags as sorted inline code spans
+func markdownTags(tags map[string]string) string {
+	pairs := make([]string, 0, len(tags))
+	for key, value := range tags {
+		pairs = append(pairs, markdownCode(key+"="+value))
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, " ")
+}
+
+// markdownCode renders a value as an inline code span
+func markdownCode(value string) string {
+	if value == "" {
+		return ""
+	}
+	return "`" + markdownCell(strings.ReplaceAll(value, "`", "'")) + "`"
+}
+
+// markdownCell escapes a value for use in a table cell
+func markdownCell(value string) string {
+	return markdownEscaper.Replace(value)
+}
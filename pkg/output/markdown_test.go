@@ -0,0 +1,29 @@
+package output
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteMarkdown(t *testing.T) {
+	resources := testResources()
+	resources[1].Name = "logs|archive"
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteMarkdown(&buf, resources, MarkdownOptions{}))
+	markdown := buf.String()
+
+	assert.Contains(t, markdown, "**2 resources** across 2 types in 2 regions")
+	assert.Contains(t, markdown, "## virtual_machine (1)")
+	assert.Contains(t, markdown, "| `i-1234567890abcdef0` | web-server | us-east-1 | running | 2024-01-15 | `Environment=prod` `Team=web` |")
+	assert.Contains(t, markdown, `logs\|archive`)
+	assert.NotContains(t, markdown, "<details>")
+
+	buf.Reset()
+	require.NoError(t, WriteMarkdown(&buf, resources, MarkdownOptions{Details: true}))
+	assert.Contains(t, buf.String(), "<summary>i-1234567890abcdef0 (web-server)</summary>")
+	assert.Contains(t, buf.String(), "| instance_type | t3.micro |")
+}
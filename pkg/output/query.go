@@ -0,0 +1,162 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/jmespath/go-jmespath"
+
+	"github.com/Tsahi-Elkayam/cloudview/pkg/models"
+)
+
+// CompileQuery parses a JMESPath expression
+func CompileQuery(expression string) (*jmespath.JMESPath, error) {
+	query, err := jmespath.Compile(expression)
+	if err != nil {
+		return nil, fmt.Errorf("invalid query %q: %w", expression, err)
+	}
+	return query, nil
+}
+
+// ApplyQuery evaluates a JMESPath expression against the JSON form of document
+func ApplyQuery(document interface{}, expression string) (interface{}, error) {
+	query, err := CompileQuery(expression)
+	if err != nil {
+		return nil, err
+	}
+
+	// JMESPath compares numbers as float64, so decode without json.Number
+	data, err := json.Marshal(document)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode data: %w", err)
+	}
+
+	var generic interface{}
+	if err := json.Unmarshal(data, &generic); err != nil {
+		return nil, fmt.Errorf("failed to decode data: %w", err)
+	}
+
+	result, err := query.Search(generic)
+	if err != nil {
+		return nil, fmt.Errorf("failed to evaluate query %q: %w", expression, err)
+	}
+
+	return result, nil
+}
+
+// ResourcesFrom converts a query result back into resources when it is a
+// resource or a list of resources, so resource renderers can be used for it
+func ResourcesFrom(result interface{}) ([]models.Resource, bool) {
+	var items []interface{}
+	switch typed := result.(type) {
+	case []interface{}:
+		items = typed
+	case map[string]interface{}:
+		items = []interface{}{typed}
+	default:
+		return nil, false
+	}
+
+	for _, item := range items {
+		fields, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if _, ok := fields["id"]; !ok {
+			return nil, false
+		}
+		if _, ok := fields["type"]; !ok {
+			return nil, false
+		}
+	}
+
+	data, err := json.Marshal(items)
+	if err != nil {
+		return nil, false
+	}
+
+	resources := []models.Resource{}
+	if err := json.Unmarshal(data, &resources); err != nil {
+		return nil, false
+	}
+
+	return resources, true
+}
+
+// WriteValueTable renders an arbitrary query result as text: scalars and
+// lists of scalars one per line, objects as key/value pairs and lists of
+// objects as a table with one column per key
+func WriteValueTable(w io.Writer, value interface{}, header bool) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+
+	switch typed := value.(type) {
+	case nil:
+	case map[string]interface{}:
+		for _, key := range sortedKeys(typed) {
+			fmt.Fprintf(tw, "%s\t%s\n", key, FormatValue(ScalarValue(typed[key])))
+		}
+	case []interface{}:
+		columns := objectColumns(typed)
+		if columns == nil {
+			for _, item := range typed {
+				fmt.Fprintln(tw, FormatValue(ScalarValue(item)))
+			}
+			break
+		}
+
+		if header {
+			headers := make([]string, len(columns))
+			for i, column := range columns {
+				headers[i] = strings.ToUpper(column)
+			}
+			fmt.Fprintln(tw, strings.Join(headers, "\t"))
+		}
+		for _, item := range typed {
+			fields := item.(map[string]interface{})
+			values := make([]string, len(columns))
+			for i, column := range columns {
+				values[i] = FormatValue(ScalarValue(fields[column]))
+			}
+			fmt.Fprintln(tw, strings.Join(values, "\t"))
+		}
+	default:
+		fmt.Fprintln(tw, FormatValue(ScalarValue(typed)))
+	}
+
+	return tw.Flush()
+}
+
+// objectColumns returns the sorted union of keys when every item is an
+// object, or nil otherwise
+func objectColumns(items []interface{}) []string {
+	if len(items) == 0 {
+		return nil
+	}
+
+	keys := make(map[string]interface{})
+	for _, item := range items {
+		fields, ok := item.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		for key := range fields {
+			keys[key] = nil
+		}
+	}
+
+	return sortedKeys(keys)
+}
+
+// sortedKeys returns the keys of a map in sorted order
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,46 @@
+package output
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyQuery(t *testing.T) {
+	document := map[string]interface{}{"resources": testResources(), "total": 2}
+
+	result, err := ApplyQuery(document, "resources[?status.state=='running'].id")
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{"i-1234567890abcdef0"}, result)
+
+	// Numbers compare numerically
+	result, err = ApplyQuery(document, "resources[?metadata.cpu_cores > `1`].name")
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{"web-server"}, result)
+
+	_, err = ApplyQuery(document, "resources[?")
+	assert.Error(t, err)
+}
+
+func TestResourcesFrom(t *testing.T) {
+	document := map[string]interface{}{"resources": testResources()}
+
+	result, err := ApplyQuery(document, "resources[?region=='us-west-2']")
+	require.NoError(t, err)
+	resources, ok := ResourcesFrom(result)
+	require.True(t, ok)
+	require.Len(t, resources, 1)
+	assert.Equal(t, "my-bucket", resources[0].ID)
+
+	result, err = ApplyQuery(document, "resources[].{id: id, state: status.state}")
+	require.NoError(t, err)
+	_, ok = ResourcesFrom(result)
+	assert.False(t, ok)
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteValueTable(&buf, result, true))
+	assert.Contains(t, buf.String(), "ID")
+	assert.Contains(t, buf.String(), "available")
+}
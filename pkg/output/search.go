@@ -0,0 +1,160 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/Tsahi-Elkayam/cloudview/pkg/models"
+)
+
+// searchedColumns are the base columns searched besides tags and metadata.
+// Type, region and status are left out since they'd match most resources.
+var searchedColumns = []string{"id", "name", "arn"}
+
+// SearchOptions controls how resources are searched
+type SearchOptions struct {
+	// Exact requires a whole value, or a whole list item, to equal the term
+	// instead of containing it
+	Exact bool
+}
+
+// FieldMatch is a field whose value matched a search term
+type FieldMatch struct {
+	Field string `json:"field"`
+	Value string `json:"value"`
+}
+
+// SearchMatch is a resource that matched a search term and the fields that matched
+type SearchMatch struct {
+	Resource models.Resource `json:"resource"`
+	Fields   []FieldMatch    `json:"fields"`
+}
+
+// SearchResources finds resources whose ID, name, ARN, tag keys or values,
+// or metadata values match term, case-insensitively
+func SearchResources(resources []models.Resource, term string, opts SearchOptions) []SearchMatch {
+	term = strings.ToLower(strings.TrimSpace(term))
+	if term == "" {
+		return nil
+	}
+
+	var matches []SearchMatch
+	for _, resource := range resources {
+		row := FlattenResource(resource)
+
+		var fields []FieldMatch
+		for _, column := range searchColumns(row) {
+			value := FormatValue(row[column])
+			matched := matchesTerm(value, term, opts)
+			if !matched && strings.HasPrefix(column, TagColumnPrefix) {
+				matched = matchesTerm(strings.TrimPrefix(column, TagColumnPrefix), term, opts)
+			}
+			if matched {
+				fields = append(fields, FieldMatch{Field: column, Value: value})
+			}
+		}
+
+		if len(fields) > 0 {
+			matches = append(matches, SearchMatch{Resource: resource, Fields: fields})
+		}
+	}
+
+	return matches
+}
+
+// searchColumns returns the searched columns of a flattened resource in
+// display order
+func searchColumns(row map[string]interface{}) []string {
+	var extra []string
+	for column := range row {
+		if strings.HasPrefix(column, TagColumnPrefix) || strings.HasPrefix(column, MetadataColumnPrefix) {
+			extra = append(extra, column)
+		}
+	}
+	sort.Slice(extra, func(i, j int) bool {
+		if gi, gj := columnGroup(extra[i]), columnGroup(extra[j]); gi != gj {
+			return gi < gj
+		}
+		return extra[i] < extra[j]
+	})
+
+	return append(append([]string{}, searchedColumns...), extra...)
+}
+
+// matchesTerm reports whether a value matches a lower-case search term
+func matchesTerm(value, term string, opts SearchOptions) bool {
+	value = strings.ToLower(value)
+	if !opts.Exact {
+		return strings.Contains(value, term)
+	}
+
+	if value == term {
+		return true
+	}
+	for _, item := range strings.Split(value, ", ") {
+		if item == term {
+			return true
+		}
+	}
+
+	// Nested metadata such as security group rules is rendered as JSON, so
+	// compare the term with each string inside it
+	if strings.HasPrefix(value, "{") || strings.HasPrefix(value, "[") {
+		var decoded interface{}
+		if err := json.Unmarshal([]byte(value), &decoded); err == nil {
+			return containsStringLeaf(decoded, term)
+		}
+	}
+	return false
+}
+
+// containsStringLeaf reports whether any string in a decoded JSON value equals term
+func containsStringLeaf(value interface{}, term string) bool {
+	switch typed := value.(type) {
+	case string:
+		return typed == term
+	case []interface{}:
+		for _, item := range typed {
+			if containsStringLeaf(item, term) {
+				return true
+			}
+		}
+	case map[string]interface{}:
+		for _, item := range typed {
+			if containsStringLeaf(item, term) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// WriteSearchTable writes one row per matched field
+func WriteSearchTable(w io.Writer, matches []SearchMatch, header bool) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+
+	if header {
+		fmt.Fprintln(tw, "TYPE\tID\tNAME\tREGION\tFIELD\tVALUE")
+	}
+	for _, match := range matches {
+		resource := match.Resource
+		for _, field := range match.Fields {
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\n",
+				resource.Type, resource.ID, resource.Name, resource.Region, field.Field, truncateValue(field.Value, 60))
+		}
+	}
+
+	return tw.Flush()
+}
+
+// truncateValue shortens long values for table output
+func truncateValue(value string, maxLen int) string {
+	if len(value) <= maxLen {
+		return value
+	}
+	return value[:maxLen-3] + "..."
+}
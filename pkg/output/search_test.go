@@ -0,0 +1,79 @@
+package output
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Tsahi-Elkayam/cloudview/pkg/models"
+)
+
+func searchTestResources() []models.Resource {
+	resources := testResources()
+	resources[0].Metadata["private_ip"] = "10.0.1.25"
+	resources[0].Metadata["public_ip"] = "203.0.113.10"
+	resources = append(resources, models.Resource{
+		ID:     "sg-0123",
+		Name:   "db-access",
+		Type:   "security_group",
+		Region: "us-east-1",
+		Metadata: map[string]interface{}{
+			"ingress_rules": []map[string]interface{}{{"cidr_blocks": []string{"10.0.1.250/32"}}},
+		},
+	})
+	return resources
+}
+
+func TestSearchResources(t *testing.T) {
+	resources := searchTestResources()
+
+	tests := []struct {
+		name   string
+		term   string
+		opts   SearchOptions
+		ids    []string
+		fields []string
+	}{
+		{"metadata value", "203.0.113.10", SearchOptions{}, []string{"i-1234567890abcdef0"}, []string{"metadata.public_ip"}},
+		{"substring", "10.0.1.25", SearchOptions{}, []string{"i-1234567890abcdef0", "sg-0123"}, []string{"metadata.private_ip", "metadata.ingress_rules"}},
+		{"exact", "10.0.1.25", SearchOptions{Exact: true}, []string{"i-1234567890abcdef0"}, []string{"metadata.private_ip"}},
+		{"exact nested value", "10.0.1.250/32", SearchOptions{Exact: true}, []string{"sg-0123"}, []string{"metadata.ingress_rules"}},
+		{"exact list item", "sg-2", SearchOptions{Exact: true}, []string{"i-1234567890abcdef0"}, []string{"metadata.security_groups"}},
+		{"case-insensitive name", "WEB-SERVER", SearchOptions{}, []string{"i-1234567890abcdef0"}, []string{"name"}},
+		{"tag key", "team", SearchOptions{}, []string{"i-1234567890abcdef0"}, []string{"tags.Team"}},
+		{"id", "my-bucket", SearchOptions{}, []string{"my-bucket"}, []string{"id", "name"}},
+		{"type is not searched", "security_group", SearchOptions{}, nil, nil},
+		{"blank term", "  ", SearchOptions{}, nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := SearchResources(resources, tt.term, tt.opts)
+
+			var ids, fields []string
+			for _, match := range matches {
+				ids = append(ids, match.Resource.ID)
+				for _, field := range match.Fields {
+					fields = append(fields, field.Field)
+				}
+			}
+			assert.Equal(t, tt.ids, ids)
+			assert.Equal(t, tt.fields, fields)
+		})
+	}
+}
+
+func TestWriteSearchTable(t *testing.T) {
+	matches := SearchResources(searchTestResources(), "203.0.113.10", SearchOptions{})
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteSearchTable(&buf, matches, true))
+
+	lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+	require.Len(t, lines, 2)
+	assert.Contains(t, string(lines[0]), "FIELD")
+	assert.Contains(t, string(lines[1]), "metadata.public_ip")
+	assert.Contains(t, string(lines[1]), "web-server")
+}
@@ -0,0 +1,225 @@
+package output
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Tsahi-Elkayam/cloudview/pkg/models"
+)
+
+// noGroupValue labels resources that don't have the grouped field
+const noGroupValue = "(none)"
+
+// DefaultSortKeys give resources a deterministic order when no sort is requested
+var DefaultSortKeys = []SortKey{
+	{Field: "provider"},
+	{Field: "region"},
+	{Field: "type"},
+	{Field: "id"},
+}
+
+// SortKey is a field to sort resources by
+type SortKey struct {
+	Field string
+	Desc  bool
+}
+
+// ParseSortKeys parses sort keys such as "region", "-created_at",
+// "metadata.instance_type:desc" or "tag:Team:asc"
+func ParseSortKeys(specs []string) ([]SortKey, error) {
+	var keys []SortKey
+	for _, spec := range specs {
+		field := strings.TrimSpace(spec)
+		key := SortKey{}
+
+		if strings.HasPrefix(field, "-") {
+			key.Desc = true
+			field = field[1:]
+		}
+
+		lower := strings.ToLower(field)
+		switch {
+		case strings.HasSuffix(lower, ":desc"):
+			key.Desc = true
+			field = field[:len(field)-len(":desc")]
+		case strings.HasSuffix(lower, ":asc"):
+			field = field[:len(field)-len(":asc")]
+		}
+
+		key.Field = NormalizeField(field)
+		if key.Field == "" || strings.HasSuffix(key.Field, ".") {
+			return nil, fmt.Errorf("invalid sort field %q", spec)
+		}
+
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+// NormalizeField maps field shorthands to flattened column names, e.g.
+// "tag:Team" to "tags.Team" and "status" to "status.state"
+func NormalizeField(field string) string {
+	field = strings.TrimPrefix(strings.TrimSpace(field), ".")
+
+	switch strings.ToLower(field) {
+	case "status", "state":
+		return "status.state"
+	case "health":
+		return "status.health"
+	}
+
+	if strings.HasPrefix(field, "tag:") {
+		return TagColumnPrefix + strings.TrimPrefix(field, "tag:")
+	}
+
+	return field
+}
+
+// SortResources sorts resources in place by keys. Ties, and all resources
+// when no keys are given, fall back to DefaultSortKeys so the order is
+// always deterministic.
+func SortResources(resources []models.Resource, keys []SortKey) {
+	keys = append(append([]SortKey(nil), keys...), DefaultSortKeys...)
+
+	rows := make([]map[string]interface{}, len(resources))
+	for i, resource := range resources {
+		rows[i] = FlattenResource(resource)
+	}
+
+	sort.Sort(&resourceSorter{resources: resources, rows: rows, keys: keys})
+}
+
+// resourceSorter sorts resources together with their flattened rows
+type resourceSorter struct {
+	resources []models.Resource
+	rows      []map[string]interface{}
+	keys      []SortKey
+}
+
+func (s *resourceSorter) Len() int { return len(s.resources) }
+
+func (s *resourceSorter) Swap(i, j int) {
+	s.resources[i], s.resources[j] = s.resources[j], s.resources[i]
+	s.rows[i], s.rows[j] = s.rows[j], s.rows[i]
+}
+
+func (s *resourceSorter) Less(i, j int) bool {
+	for _, key := range s.keys {
+		a, aok := s.rows[i][key.Field]
+		b, bok := s.rows[j][key.Field]
+
+		// Missing values sort last in either direction
+		aok = aok && !isEmptyValue(a)
+		bok = bok && !isEmptyValue(b)
+		if aok != bok {
+			return aok
+		}
+		if !aok {
+			continue
+		}
+
+		if c := compareValues(a, b); c != 0 {
+			if key.Desc {
+				return c > 0
+			}
+			return c < 0
+		}
+	}
+	return false
+}
+
+// isEmptyValue reports whether a flattened value should be treated as missing
+func isEmptyValue(value interface{}) bool {
+	switch typed := value.(type) {
+	case nil:
+		return true
+	case string:
+		return typed == ""
+	case time.Time:
+		return typed.IsZero()
+	}
+	return false
+}
+
+// compareValues compares two flattened values, numerically or chronologically
+// when both have the same kind and as text otherwise
+func compareValues(a, b interface{}) int {
+	if x, ok := toFloat(a); ok {
+		if y, ok := toFloat(b); ok {
+			switch {
+			case x < y:
+				return -1
+			case x > y:
+				return 1
+			}
+			return 0
+		}
+	}
+
+	if x, ok := a.(time.Time); ok {
+		if y, ok := b.(time.Time); ok {
+			return x.Compare(y)
+		}
+	}
+
+	x, y := FormatValue(a), FormatValue(b)
+	if c := strings.Compare(strings.ToLower(x), strings.ToLower(y)); c != 0 {
+		return c
+	}
+	return strings.Compare(x, y)
+}
+
+// toFloat converts numeric flattened values to float64
+func toFloat(value interface{}) (float64, bool) {
+	switch typed := value.(type) {
+	case int64:
+		return float64(typed), true
+	case uint64:
+		return float64(typed), true
+	case float64:
+		return typed, true
+	}
+	return 0, false
+}
+
+// ResourceGroup is a set of resources sharing a group-by value
+type ResourceGroup struct {
+	Key       string
+	Resources []models.Resource
+}
+
+// GroupResources groups resources by a field such as "region" or "tag:Team".
+// Groups are ordered by key with resources missing the field last; resources
+// keep their relative order within a group.
+func GroupResources(resources []models.Resource, field string) []ResourceGroup {
+	field = NormalizeField(field)
+
+	index := make(map[string]int)
+	var groups []ResourceGroup
+	for _, resource := range resources {
+		key := FormatValue(FlattenResource(resource)[field])
+		if key == "" {
+			key = noGroupValue
+		}
+
+		i, ok := index[key]
+		if !ok {
+			i = len(groups)
+			index[key] = i
+			groups = append(groups, ResourceGroup{Key: key})
+		}
+		groups[i].Resources = append(groups[i].Resources, resource)
+	}
+
+	sort.SliceStable(groups, func(i, j int) bool {
+		if (groups[i].Key == noGroupValue) != (groups[j].Key == noGroupValue) {
+			return groups[j].Key == noGroupValue
+		}
+		return compareValues(groups[i].Key, groups[j].Key) < 0
+	})
+
+	return groups
+}
@@ -0,0 +1,81 @@
+package output
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Tsahi-Elkayam/cloudview/pkg/models"
+)
+
+func sortTestResources() []models.Resource {
+	day := func(d int) time.Time { return time.Date(2024, 1, d, 0, 0, 0, 0, time.UTC) }
+	return []models.Resource{
+		{ID: "c", Provider: "aws", Region: "us-west-2", Type: "vpc", CreatedAt: day(3), Tags: map[string]string{"Team": "web"}},
+		{ID: "a", Provider: "aws", Region: "us-east-1", Type: "vpc", CreatedAt: day(1)},
+		{ID: "b", Provider: "aws", Region: "us-east-1", Type: "vpc", CreatedAt: day(2), Tags: map[string]string{"Team": "data"},
+			Metadata: map[string]interface{}{"cpu_cores": 16}},
+		{ID: "d", Provider: "aws", Region: "us-east-1", Type: "virtual_machine", CreatedAt: day(4), Tags: map[string]string{"Team": "web"},
+			Metadata: map[string]interface{}{"cpu_cores": 4}},
+	}
+}
+
+func ids(resources []models.Resource) []string {
+	result := make([]string, len(resources))
+	for i, resource := range resources {
+		result[i] = resource.ID
+	}
+	return result
+}
+
+func TestParseSortKeys(t *testing.T) {
+	keys, err := ParseSortKeys([]string{"region", "-created_at", "tag:Team:desc", "metadata.cpu_cores:asc", "status"})
+	require.NoError(t, err)
+	assert.Equal(t, []SortKey{
+		{Field: "region"},
+		{Field: "created_at", Desc: true},
+		{Field: "tags.Team", Desc: true},
+		{Field: "metadata.cpu_cores"},
+		{Field: "status.state"},
+	}, keys)
+
+	_, err = ParseSortKeys([]string{"-"})
+	assert.Error(t, err)
+}
+
+func TestSortResources(t *testing.T) {
+	resources := sortTestResources()
+
+	// Default order: provider, region, type, id
+	SortResources(resources, nil)
+	assert.Equal(t, []string{"d", "a", "b", "c"}, ids(resources))
+
+	SortResources(resources, []SortKey{{Field: "created_at", Desc: true}})
+	assert.Equal(t, []string{"d", "c", "b", "a"}, ids(resources))
+
+	// Numeric comparison, missing values last
+	SortResources(resources, []SortKey{{Field: "metadata.cpu_cores"}})
+	assert.Equal(t, []string{"d", "b", "a", "c"}, ids(resources))
+
+	SortResources(resources, []SortKey{{Field: "tags.Team", Desc: true}, {Field: "id", Desc: true}})
+	assert.Equal(t, []string{"d", "c", "b", "a"}, ids(resources))
+}
+
+func TestGroupResources(t *testing.T) {
+	resources := sortTestResources()
+	SortResources(resources, nil)
+
+	groups := GroupResources(resources, "tag:Team")
+	require.Len(t, groups, 3)
+	assert.Equal(t, "data", groups[0].Key)
+	assert.Equal(t, "web", groups[1].Key)
+	assert.Equal(t, []string{"d", "c"}, ids(groups[1].Resources))
+	assert.Equal(t, "(none)", groups[2].Key)
+
+	groups = GroupResources(resources, "region")
+	require.Len(t, groups, 2)
+	assert.Equal(t, "us-east-1", groups[0].Key)
+	assert.Len(t, groups[0].Resources, 3)
+}
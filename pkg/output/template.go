@@ -0,0 +1,81 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/template"
+)
+
+// templateFuncs are the helper functions available to go-template output
+var templateFuncs = template.FuncMap{
+	"join":  strings.Join,
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+	"json": func(v interface{}) (string, error) {
+		data, err := json.Marshal(v)
+		return string(data), err
+	},
+}
+
+// Generic converts v into the generic form it has when decoded from JSON
+// (maps, slices, strings, booleans and json.Number), so templates and paths
+// address fields by their JSON names such as .metadata.instance_type
+func Generic(v interface{}) (interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode data: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var generic interface{}
+	if err := decoder.Decode(&generic); err != nil {
+		return nil, fmt.Errorf("failed to decode data: %w", err)
+	}
+
+	return generic, nil
+}
+
+// ParseTemplate parses a Go template for rendering output documents
+func ParseTemplate(text string) (*template.Template, error) {
+	if strings.TrimSpace(text) == "" {
+		return nil, fmt.Errorf("template is empty")
+	}
+
+	tmpl, err := template.New("output").Funcs(templateFuncs).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid template: %w", err)
+	}
+
+	return tmpl, nil
+}
+
+// ParseTemplateFile parses a Go template read from a file
+func ParseTemplateFile(path string) (*template.Template, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read template file: %w", err)
+	}
+
+	return ParseTemplate(string(data))
+}
+
+// WriteTemplate renders document with tmpl. The document is converted to its
+// JSON form first so field names match the json and yaml output.
+func WriteTemplate(w io.Writer, tmpl *template.Template, document interface{}) error {
+	generic, err := Generic(document)
+	if err != nil {
+		return err
+	}
+
+	if err := tmpl.Execute(w, generic); err != nil {
+		return fmt.Errorf("failed to execute template: %w", err)
+	}
+
+	return nil
+}
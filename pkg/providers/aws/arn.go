@@ -0,0 +1,34 @@
+package aws
+
+import (
+	"fmt"
+	"strings"
+)
+
+// buildARN builds an Amazon Resource Name for resources whose API responses
+// don't include one
+func buildARN(service, region, accountID, resource string) string {
+	return fmt.Sprintf("arn:%s:%s:%s:%s:%s", partitionForRegion(region), service, region, accountID, resource)
+}
+
+// partitionForRegion returns the AWS partition a region belongs to
+func partitionForRegion(region string) string {
+	switch {
+	case strings.HasPrefix(region, "cn-"):
+		return "aws-cn"
+	case strings.HasPrefix(region, "us-gov-"):
+		return "aws-us-gov"
+	default:
+		return "aws"
+	}
+}
+
+// parseARN splits an Amazon Resource Name into its service, region and
+// resource parts
+func parseARN(arn string) (service, region, resource string, ok bool) {
+	parts := strings.SplitN(arn, ":", 6)
+	if len(parts) != 6 || parts[0] != "arn" {
+		return "", "", "", false
+	}
+	return parts[2], parts[3], parts[5], true
+}
@@ -0,0 +1,104 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/smithy-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Tsahi-Elkayam/cloudview/pkg/config"
+	shared "github.com/Tsahi-Elkayam/cloudview/pkg/types"
+)
+
+func TestParseARN(t *testing.T) {
+	service, region, resource, ok := parseARN("arn:aws:rds:us-east-1:123456789012:db:orders-db")
+	require.True(t, ok)
+	assert.Equal(t, "rds", service)
+	assert.Equal(t, "us-east-1", region)
+	assert.Equal(t, "db:orders-db", resource)
+
+	_, _, _, ok = parseARN("i-1234567890abcdef0")
+	assert.False(t, ok)
+}
+
+func TestLookupRegions(t *testing.T) {
+	assert.Equal(t, []string{"eu-west-1"}, lookupRegions("arn:aws:rds:eu-west-1:123456789012:db:orders-db"))
+	assert.Nil(t, lookupRegions("arn:aws:s3:::my-bucket"))
+	assert.Nil(t, lookupRegions("orders-db"))
+
+	assert.Equal(t, "i-1", arnResourceName("arn:aws:ec2:us-east-1:123456789012:instance/i-1"))
+	assert.Equal(t, "orders", arnResourceName("arn:aws:dynamodb:us-east-1:123456789012:table/orders"))
+	assert.Equal(t, "i-1", arnResourceName("i-1"))
+}
+
+func TestLookupErrors(t *testing.T) {
+	notFound := &smithy.GenericAPIError{Code: "DBInstanceNotFound", Message: "not found"}
+	denied := &smithy.GenericAPIError{Code: "AccessDenied", Message: "denied"}
+
+	assert.True(t, hasErrorCode(fmt.Errorf("describe: %w", notFound), "DBInstanceNotFound"))
+	assert.False(t, hasErrorCode(denied, "DBInstanceNotFound"))
+	assert.False(t, hasErrorCode(errors.New("connection reset"), "DBInstanceNotFound"))
+
+	assert.ErrorIs(t, errNotFound("RDS database", "orders-db"), shared.ErrResourceNotFound)
+
+	throttled := classifyLookupError(fmt.Errorf("describe: %w", &smithy.GenericAPIError{Code: "ThrottlingException"}))
+	assert.ErrorIs(t, throttled, shared.ErrRateLimitExceeded)
+	assert.Contains(t, throttled.Error(), "ThrottlingException")
+	assert.NotErrorIs(t, classifyLookupError(denied), shared.ErrRateLimitExceeded)
+	assert.NotErrorIs(t, classifyLookupError(denied), shared.ErrServiceUnavailable)
+	assert.EqualError(t, errNotFound("RDS database", "orders-db"), "RDS database orders-db not found")
+}
+
+func TestStatusLookups(t *testing.T) {
+	provider, err := NewAWSProvider(&config.AWSConfig{Region: "us-east-1"}, nil)
+	require.NoError(t, err)
+	provider.accountID = "123456789012"
+
+	tests := []struct {
+		resourceID string
+		expected   map[string]string // lookup description to ID
+	}{
+		{"i-1234567890abcdef0", map[string]string{"EC2 instance": "i-1234567890abcdef0"}},
+		{"vpc-0a1b2c3d", map[string]string{"VPC": "vpc-0a1b2c3d"}},
+		{"sg-0a1b2c3d", map[string]string{"security group": "sg-0a1b2c3d"}},
+		{"vol-0a1b2c3d", map[string]string{"EBS volume": "vol-0a1b2c3d"}},
+		{"snap-0a1b2c3d", map[string]string{"EBS snapshot": "snap-0a1b2c3d"}},
+		{"arn:aws:ec2:us-east-1::snapshot/snap-1", map[string]string{"EBS snapshot": "arn:aws:ec2:us-east-1::snapshot/snap-1"}},
+		{"arn:aws:ec2:us-east-1:123456789012:instance/i-1", map[string]string{"EC2 instance": "arn:aws:ec2:us-east-1:123456789012:instance/i-1"}},
+		{"arn:aws:ec2:us-east-1:123456789012:security-group/sg-1", map[string]string{"security group": "arn:aws:ec2:us-east-1:123456789012:security-group/sg-1"}},
+		{"arn:aws:rds:us-east-1:123456789012:cluster:orders", map[string]string{"RDS cluster": "arn:aws:rds:us-east-1:123456789012:cluster:orders"}},
+		{"arn:aws:s3:::my-bucket", map[string]string{"S3 bucket": "my-bucket"}},
+		{"arn:aws:iam::123456789012:role/service/deployer", map[string]string{"IAM role": "deployer"}},
+		{"arn:aws:lambda:us-east-1:123456789012:function:orders-api", map[string]string{"Lambda function": "arn:aws:lambda:us-east-1:123456789012:function:orders-api"}},
+		{"arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/web/50dc6c495c0c9188", map[string]string{"load balancer": "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/web/50dc6c495c0c9188"}},
+		{"arn:aws:ecs:us-east-1:123456789012:service/prod/web", map[string]string{"ECS service": "arn:aws:ecs:us-east-1:123456789012:service/prod/web"}},
+		{"arn:aws:ecs:us-east-1:123456789012:cluster/prod", map[string]string{"ECS cluster": "arn:aws:ecs:us-east-1:123456789012:cluster/prod"}},
+		{"prod/web", map[string]string{"ECS service": "prod/web", "ECS task": "prod/web"}},
+		{"arn:aws:dynamodb:us-east-1:123456789012:table/orders", map[string]string{"DynamoDB table": "arn:aws:dynamodb:us-east-1:123456789012:table/orders"}},
+		{"orders-db", map[string]string{
+			"RDS database":    "orders-db",
+			"RDS cluster":     "orders-db",
+			"DynamoDB table":  "orders-db",
+			"S3 bucket":       "orders-db",
+			"Lambda function": "orders-db",
+			"load balancer":   "orders-db",
+			"ECS cluster":     "orders-db",
+			"IAM user":        "orders-db",
+			"IAM role":        "orders-db",
+			"IAM policy":      "arn:aws:iam::123456789012:policy/orders-db",
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.resourceID, func(t *testing.T) {
+			lookups := make(map[string]string)
+			for _, lookup := range provider.statusLookups(tt.resourceID) {
+				lookups[lookup.description] = lookup.id
+			}
+			assert.Equal(t, tt.expected, lookups)
+		})
+	}
+}
@@ -2,12 +2,18 @@ package aws
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"sync"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
 	"github.com/aws/aws-sdk-go-v2/service/rds"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/sirupsen/logrus"
@@ -26,14 +32,20 @@ type AWSProvider struct {
 	logger        *logrus.Logger
 	
 	// Service clients
-	ec2Service *EC2Service
-	s3Service  *S3Service
-	iamService *IAMService
-	rdsService *RDSService
-	vpcService *VPCService
+	ec2Service    *EC2Service
+	s3Service     *S3Service
+	iamService    *IAMService
+	rdsService    *RDSService
+	vpcService    *VPCService
+	ebsService    *EBSService
+	elbService    *ELBService
+	lambdaService *LambdaService
+	ecsService    *ECSService
+	dynamoService *DynamoDBService
 	
 	// State
 	authenticated bool
+	accountID     string
 	mu            sync.RWMutex
 }
 
@@ -101,18 +113,19 @@ func (p *AWSProvider) Authenticate(ctx context.Context, cfg config.ProviderConfi
 	p.awsConfig = awsCfg
 	p.authenticated = true
 	
-	// Initialize services
-	if err := p.initializeServices(); err != nil {
-		p.authenticated = false
-		return fmt.Errorf("failed to initialize AWS services: %w", err)
-	}
-	
-	// Validate credentials
+	// Validate credentials; services need the account ID
 	identity, err := p.authenticator.ValidateCredentials(ctx)
 	if err != nil {
 		p.authenticated = false
 		return fmt.Errorf("AWS credential validation failed: %w", err)
 	}
+	p.accountID = aws.ToString(identity.Account)
+	
+	// Initialize services
+	if err := p.initializeServices(); err != nil {
+		p.authenticated = false
+		return fmt.Errorf("failed to initialize AWS services: %w", err)
+	}
 	
 	p.logger.Infof("Successfully authenticated with AWS as %s (Account: %s)", 
 		aws.ToString(identity.Arn), 
@@ -128,165 +141,127 @@ func (p *AWSProvider) IsAuthenticated() bool {
 	return p.authenticated
 }
 
+// AccountID returns the AWS account ID the provider is authenticated against
+func (p *AWSProvider) AccountID() string {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.accountID
+}
+
 // GetResources retrieves all resources with the given filters
 func (p *AWSProvider) GetResources(ctx context.Context, filters types.ResourceFilters) ([]models.Resource, error) {
-	if !p.IsAuthenticated() {
-		return nil, fmt.Errorf("AWS provider is not authenticated")
+	events, err := p.StreamResources(ctx, filters)
+	if err != nil {
+		return nil, err
 	}
 	
 	var allResources []models.Resource
-	var wg sync.WaitGroup
-	var mu sync.Mutex
-	resourceChan := make(chan []models.Resource, 25)
-	errorChan := make(chan error, 25)
-	
-	// Get EC2 instances
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-		resources, err := p.ec2Service.GetInstances(ctx, filters)
-		if err != nil {
-			errorChan <- fmt.Errorf("failed to get EC2 instances: %w", err)
-			return
+	for event := range events {
+		switch event.Type {
+		case types.DiscoveryEventResource:
+			allResources = append(allResources, *event.Resource)
+		case types.DiscoveryEventServiceDone:
+			// Log any errors but don't fail completely
+			if event.Err != nil {
+				p.logger.Warn(event.Err)
+			}
 		}
-		resourceChan <- resources
-	}()
+	}
 	
-	// Get S3 buckets
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-		resources, err := p.s3Service.GetBuckets(ctx, filters)
-		if err != nil {
-			errorChan <- fmt.Errorf("failed to get S3 buckets: %w", err)
-			return
-		}
-		resourceChan <- resources
-	}()
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
 	
-	// Get RDS databases
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-		resources, err := p.rdsService.GetDatabases(ctx, filters)
-		if err != nil {
-			errorChan <- fmt.Errorf("failed to get RDS databases: %w", err)
-			return
-		}
-		resourceChan <- resources
-	}()
+	p.logger.Debugf("Retrieved %d resources from AWS", len(allResources))
+	return allResources, nil
+}
+
+// StreamResources discovers resources with the given filters and emits them
+// as each service finishes a region, followed by a completion event per
+// service. Services are queried concurrently; the channel is closed once
+// all of them are done.
+func (p *AWSProvider) StreamResources(ctx context.Context, filters types.ResourceFilters) (<-chan types.DiscoveryEvent, error) {
+	if !p.IsAuthenticated() {
+		return nil, fmt.Errorf("AWS provider is not authenticated")
+	}
 	
-	// Get RDS clusters
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-		resources, err := p.rdsService.GetClusters(ctx, filters)
-		if err != nil {
-			errorChan <- fmt.Errorf("failed to get RDS clusters: %w", err)
-			return
-		}
-		resourceChan <- resources
-	}()
+	events := make(chan types.DiscoveryEvent)
+	var wg sync.WaitGroup
 	
-	// Get IAM users
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-		resources, err := p.iamService.GetUsers(ctx, filters)
-		if err != nil {
-			errorChan <- fmt.Errorf("failed to get IAM users: %w", err)
-			return
+	// Query every service selected by the type filter concurrently
+	for _, fetcher := range p.resourceFetchers() {
+		if !fetcher.selectedBy(filters.ResourceTypes) {
+			continue
 		}
-		resourceChan <- resources
-	}()
+		
+		wg.Add(1)
+		go func(fetcher resourceFetcher) {
+			defer wg.Done()
+			p.streamFetcher(ctx, fetcher, filters, events)
+		}(fetcher)
+	}
 	
-	// Get IAM roles
-	wg.Add(1)
+	// Close the stream once all services are done
 	go func() {
-		defer wg.Done()
-		resources, err := p.iamService.GetRoles(ctx, filters)
-		if err != nil {
-			errorChan <- fmt.Errorf("failed to get IAM roles: %w", err)
-			return
-		}
-		resourceChan <- resources
+		wg.Wait()
+		close(events)
 	}()
 	
-	// Get IAM policies
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-		resources, err := p.iamService.GetPolicies(ctx, filters)
-		if err != nil {
-			errorChan <- fmt.Errorf("failed to get IAM policies: %w", err)
-			return
-		}
-		resourceChan <- resources
-	}()
+	return events, nil
+}
+
+// streamFetcher runs a fetcher and sends its resources and completion event.
+// Regional services are queried one region at a time so results arrive
+// incrementally.
+func (p *AWSProvider) streamFetcher(ctx context.Context, fetcher resourceFetcher, filters types.ResourceFilters, events chan<- types.DiscoveryEvent) {
+	done := types.DiscoveryEvent{
+		Type:     types.DiscoveryEventServiceDone,
+		Provider: p.Name(),
+		Service:  fetcher.description,
+	}
 	
-	// Get VPCs
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-		resources, err := p.vpcService.GetVPCs(ctx, filters)
-		if err != nil {
-			errorChan <- fmt.Errorf("failed to get VPCs: %w", err)
-			return
+	regions := [][]string{filters.Regions}
+	if fetcher.regional {
+		regions = nil
+		for _, region := range p.regionsToQuery(filters.Regions) {
+			regions = append(regions, []string{region})
 		}
-		resourceChan <- resources
-	}()
+	}
 	
-	// Get Security Groups
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-		resources, err := p.vpcService.GetSecurityGroups(ctx, filters)
+	for _, region := range regions {
+		regionFilters := filters
+		regionFilters.Regions = region
+		
+		resources, err := fetcher.fetch(ctx, regionFilters)
 		if err != nil {
-			errorChan <- fmt.Errorf("failed to get security groups: %w", err)
+			done.Err = fmt.Errorf("failed to get %s: %w", fetcher.description, err)
+			break
+		}
+		if !types.SendResources(ctx, events, p.Name(), fetcher.description, resources) {
 			return
 		}
-		resourceChan <- resources
-	}()
+		done.Count += len(resources)
+	}
 	
-	// Wait for all goroutines to complete
-	go func() {
-		wg.Wait()
-		close(resourceChan)
-		close(errorChan)
-	}()
+	types.SendEvent(ctx, events, done)
+}
+
+// regionsToQuery returns the regions regional services query: the filtered
+// regions, the configured regions or the primary region
+func (p *AWSProvider) regionsToQuery(filterRegions []string) []string {
+	if len(filterRegions) > 0 {
+		return filterRegions
+	}
 	
-	// Collect results
-	var errors []error
-	for {
-		select {
-		case resources, ok := <-resourceChan:
-			if !ok {
-				resourceChan = nil
-			} else {
-				mu.Lock()
-				allResources = append(allResources, resources...)
-				mu.Unlock()
-			}
-		case err, ok := <-errorChan:
-			if !ok {
-				errorChan = nil
-			} else {
-				errors = append(errors, err)
-			}
-		}
-		
-		if resourceChan == nil && errorChan == nil {
-			break
-		}
+	if configRegions := p.config.GetRegions(); len(configRegions) > 0 {
+		return configRegions
 	}
 	
-	// Log any errors but don't fail completely
-	for _, err := range errors {
-		p.logger.Warn(err)
+	if p.config.Region != "" {
+		return []string{p.config.Region}
 	}
 	
-	p.logger.Debugf("Retrieved %d resources from AWS", len(allResources))
-	return allResources, nil
+	return []string{"us-east-1"}
 }
 
 // GetResourcesByType retrieves resources of a specific type
@@ -295,60 +270,221 @@ func (p *AWSProvider) GetResourcesByType(ctx context.Context, resourceType strin
 		return nil, fmt.Errorf("AWS provider is not authenticated")
 	}
 	
-	switch resourceType {
-	// EC2 resources
-	case "ec2", "virtual_machine", "instance":
-		return p.ec2Service.GetInstances(ctx, filters)
-	
-	// S3 resources
-	case "s3", "bucket", "object_storage":
-		return p.s3Service.GetBuckets(ctx, filters)
-	
-	// RDS resources
-	case "rds", "rds_instance", "database", "postgres", "postgresql", "mysql":
-		return p.rdsService.GetDatabases(ctx, filters)
-	case "rds_cluster", "aurora", "cluster":
-		return p.rdsService.GetClusters(ctx, filters)
-	
-	// IAM resources
-	case "iam", "iam_user", "user":
-		return p.iamService.GetUsers(ctx, filters)
-	case "iam_role", "role":
-		return p.iamService.GetRoles(ctx, filters)
-	case "iam_policy", "policy":
-		return p.iamService.GetPolicies(ctx, filters)
-	
-	// VPC resources  
-	case "vpc", "network":
-		return p.vpcService.GetVPCs(ctx, filters)
-	case "security_group", "firewall", "sg":
-		return p.vpcService.GetSecurityGroups(ctx, filters)
-	
-	default:
+	if _, ok := models.ResolveResourceType(resourceType); !ok {
+		return nil, fmt.Errorf("unsupported resource type: %s", resourceType)
+	}
+	
+	var resources []models.Resource
+	supported := false
+	for _, fetcher := range p.resourceFetchers() {
+		if !fetcher.selectedBy([]string{resourceType}) {
+			continue
+		}
+		supported = true
+		
+		fetched, err := fetcher.fetch(ctx, filters)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get %s: %w", fetcher.description, err)
+		}
+		resources = append(resources, fetched...)
+	}
+	
+	if !supported {
 		return nil, fmt.Errorf("unsupported resource type: %s", resourceType)
 	}
+	return resources, nil
+}
+
+// resourceFetcher lists the resources of one native type. Regional fetchers
+// only query the regions in their filters, so they can be run per region.
+type resourceFetcher struct {
+	nativeType  string
+	description string
+	fetch       func(ctx context.Context, filters types.ResourceFilters) ([]models.Resource, error)
+	regional    bool
+}
+
+// resourceFetchers returns a fetcher for every native resource type the provider supports
+func (p *AWSProvider) resourceFetchers() []resourceFetcher {
+	return []resourceFetcher{
+		{models.NativeTypeEC2Instance, "EC2 instances", p.ec2Service.GetInstances, true},
+		{models.NativeTypeS3Bucket, "S3 buckets", p.s3Service.GetBuckets, false},
+		{models.NativeTypeRDSInstance, "RDS databases", p.rdsService.GetDatabases, true},
+		{models.NativeTypeRDSCluster, "RDS clusters", p.rdsService.GetClusters, true},
+		{models.NativeTypeDynamoDBTable, "DynamoDB tables", p.dynamoService.GetTables, true},
+		{models.NativeTypeIAMUser, "IAM users", p.iamService.GetUsers, false},
+		{models.NativeTypeIAMRole, "IAM roles", p.iamService.GetRoles, false},
+		{models.NativeTypeIAMPolicy, "IAM policies", p.iamService.GetPolicies, false},
+		{models.NativeTypeVPC, "VPCs", p.vpcService.GetVPCs, true},
+		{models.NativeTypeEC2SecurityGroup, "security groups", p.vpcService.GetSecurityGroups, true},
+		{models.NativeTypeEBSVolume, "EBS volumes", p.ebsService.GetVolumes, true},
+		{models.NativeTypeEBSSnapshot, "EBS snapshots", p.ebsService.GetSnapshots, true},
+		{models.NativeTypeELBv2LoadBalancer, "load balancers", p.elbService.GetLoadBalancers, true},
+		{models.NativeTypeLambdaFunction, "Lambda functions", p.lambdaService.GetFunctions, true},
+		{models.NativeTypeECSCluster, "ECS clusters", p.ecsService.GetClusters, true},
+		{models.NativeTypeECSService, "ECS services", p.ecsService.GetServices, true},
+		{models.NativeTypeECSTask, "ECS tasks", p.ecsService.GetTasks, true},
+	}
 }
 
-// GetResourceStatus retrieves the status of a specific resource
+// selectedBy reports whether a resource type filter includes the fetcher's
+// resources. An empty filter selects everything.
+func (f resourceFetcher) selectedBy(resourceTypes []string) bool {
+	if len(resourceTypes) == 0 {
+		return true
+	}
+	
+	resourceType := models.GetNativeResourceType(f.nativeType)
+	for _, name := range resourceTypes {
+		selectors, _ := models.ResolveResourceType(name)
+		for _, selector := range selectors {
+			if selector.Selects(resourceType, f.nativeType) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// GetResourceStatus retrieves the live status of a resource by ID, name or ARN
 func (p *AWSProvider) GetResourceStatus(ctx context.Context, resourceID string) (*models.ResourceStatus, error) {
 	if !p.IsAuthenticated() {
 		return nil, fmt.Errorf("AWS provider is not authenticated")
 	}
 	
-	// Try to find the resource in EC2
-	if status, err := p.ec2Service.GetInstanceStatus(ctx, resourceID); err == nil {
-		return status, nil
+	// Failures such as access denied or throttling are reported unless
+	// another kind of resource matches. Bucket names are global, so a plain
+	// name can hit another account's bucket and be refused.
+	var failure error
+	lookups := p.statusLookups(resourceID)
+	for _, lookup := range lookups {
+		status, err := lookup.status(ctx, lookup.id)
+		if err == nil {
+			return status, nil
+		}
+		
+		if !errors.Is(err, types.ErrResourceNotFound) {
+			p.logger.Debugf("Failed to look up %s %s: %v", lookup.description, resourceID, err)
+			if failure == nil {
+				failure = classifyLookupError(err)
+			}
+			continue
+		}
+		p.logger.Debugf("No %s matches %s: %v", lookup.description, resourceID, err)
+		
+		// Stop once a lookup was chosen by an ARN or ID prefix
+		if len(lookups) == 1 {
+			return nil, err
+		}
 	}
 	
-	// Try to find the resource in S3
-	if status, err := p.s3Service.GetBucketStatus(ctx, resourceID); err == nil {
-		return status, nil
+	if failure != nil {
+		return nil, failure
+	}
+	return nil, errNotFound("resource", resourceID)
+}
+
+// statusLookup is a way to look up a resource's status
+type statusLookup struct {
+	description string
+	id          string
+	status      func(ctx context.Context, id string) (*models.ResourceStatus, error)
+}
+
+// statusLookups returns the status lookups to try for a resource ID. ARNs
+// and prefixed IDs such as i-, vpc-, sg- and vol- select a single lookup, and
+// cluster/name IDs are ECS services or tasks; plain names may be databases,
+// clusters, DynamoDB tables, buckets, functions, load balancers or IAM entities.
+// Regional ARNs are passed on whole so that only their region is searched.
+func (p *AWSProvider) statusLookups(resourceID string) []statusLookup {
+	ec2Instance := statusLookup{"EC2 instance", resourceID, p.ec2Service.GetInstanceStatus}
+	vpc := statusLookup{"VPC", resourceID, p.vpcService.GetVPCStatus}
+	securityGroup := statusLookup{"security group", resourceID, p.vpcService.GetSecurityGroupStatus}
+	volume := statusLookup{"EBS volume", resourceID, p.ebsService.GetVolumeStatus}
+	snapshot := statusLookup{"EBS snapshot", resourceID, p.ebsService.GetSnapshotStatus}
+	database := statusLookup{"RDS database", resourceID, p.rdsService.GetDatabaseStatus}
+	cluster := statusLookup{"RDS cluster", resourceID, p.rdsService.GetClusterStatus}
+	table := statusLookup{"DynamoDB table", resourceID, p.dynamoService.GetTableStatus}
+	bucket := statusLookup{"S3 bucket", resourceID, p.s3Service.GetBucketStatus}
+	user := statusLookup{"IAM user", resourceID, p.iamService.GetUserStatus}
+	role := statusLookup{"IAM role", resourceID, p.iamService.GetRoleStatus}
+	policy := statusLookup{"IAM policy", resourceID, p.iamService.GetPolicyStatus}
+	function := statusLookup{"Lambda function", resourceID, p.lambdaService.GetFunctionStatus}
+	loadBalancer := statusLookup{"load balancer", resourceID, p.elbService.GetLoadBalancerStatus}
+	ecsCluster := statusLookup{"ECS cluster", resourceID, p.ecsService.GetClusterStatus}
+	ecsService := statusLookup{"ECS service", resourceID, p.ecsService.GetServiceStatus}
+	ecsTask := statusLookup{"ECS task", resourceID, p.ecsService.GetTaskStatus}
+	
+	if service, _, resource, ok := parseARN(resourceID); ok {
+		kind, name, _ := strings.Cut(resource, "/")
+		if service == "rds" || service == "lambda" {
+			kind, name, _ = strings.Cut(resource, ":")
+		}
+		// IAM names may include a path
+		if service == "iam" {
+			name = name[strings.LastIndex(name, "/")+1:]
+		}
+		
+		switch {
+		case service == "ec2" && kind == "instance":
+			return []statusLookup{ec2Instance}
+		case service == "ec2" && kind == "vpc":
+			return []statusLookup{vpc}
+		case service == "ec2" && kind == "security-group":
+			return []statusLookup{securityGroup}
+		case service == "ec2" && kind == "volume":
+			return []statusLookup{volume}
+		case service == "ec2" && kind == "snapshot":
+			return []statusLookup{snapshot}
+		case service == "rds" && kind == "db":
+			return []statusLookup{database}
+		case service == "rds" && kind == "cluster":
+			return []statusLookup{cluster}
+		case service == "dynamodb" && kind == "table":
+			return []statusLookup{table}
+		case service == "s3":
+			bucket.id = kind
+			return []statusLookup{bucket}
+		case service == "iam" && kind == "user":
+			user.id = name
+			return []statusLookup{user}
+		case service == "iam" && kind == "role":
+			role.id = name
+			return []statusLookup{role}
+		case service == "iam" && kind == "policy":
+			return []statusLookup{policy}
+		case service == "lambda" && kind == "function":
+			return []statusLookup{function}
+		case service == "elasticloadbalancing" && kind == "loadbalancer":
+			return []statusLookup{loadBalancer}
+		case service == "ecs" && kind == "cluster":
+			return []statusLookup{ecsCluster}
+		case service == "ecs" && kind == "service":
+			return []statusLookup{ecsService}
+		case service == "ecs" && kind == "task":
+			return []statusLookup{ecsTask}
+		}
+		return nil
 	}
 	
-	// For IAM and RDS, we can assume they're active if they exist
-	// (We'd need separate status checking methods for more detailed status)
+	switch {
+	case strings.HasPrefix(resourceID, "i-"):
+		return []statusLookup{ec2Instance}
+	case strings.HasPrefix(resourceID, "vpc-"):
+		return []statusLookup{vpc}
+	case strings.HasPrefix(resourceID, "sg-"):
+		return []statusLookup{securityGroup}
+	case strings.HasPrefix(resourceID, "vol-"):
+		return []statusLookup{volume}
+	case strings.HasPrefix(resourceID, "snap-"):
+		return []statusLookup{snapshot}
+	case strings.Count(resourceID, "/") == 1:
+		return []statusLookup{ecsService, ecsTask}
+	}
 	
-	return nil, fmt.Errorf("resource %s not found", resourceID)
+	// Managed policies are looked up by ARN
+	policy.id = fmt.Sprintf("arn:%s:iam::%s:policy/%s", partitionForRegion(p.config.Region), p.AccountID(), resourceID)
+	return []statusLookup{database, cluster, table, bucket, function, loadBalancer, ecsCluster, user, role, policy}
 }
 
 // ValidateConfig validates the AWS configuration
@@ -363,24 +499,16 @@ func (p *AWSProvider) ValidateConfig(cfg config.ProviderConfig) error {
 
 // GetSupportedResourceTypes returns the list of supported resource types
 func (p *AWSProvider) GetSupportedResourceTypes() []string {
-	return []string{
-		// EC2 resources
-		"ec2", "instance", "virtual_machine",
-		
-		// S3 resources
-		"s3", "bucket", "object_storage",
-		
-		// RDS resources
-		"rds", "rds_instance", "rds_cluster", "database", 
-		"postgres", "postgresql", "mysql", "aurora", "cluster",
-		
-		// IAM resources
-		"iam", "iam_user", "iam_role", "iam_policy",
-		"user", "role", "policy",
-		
-		// VPC resources
-		"vpc", "network", "security_group", "firewall", "sg",
+	var supported []string
+	for _, name := range models.ResourceTypeNames() {
+		for _, fetcher := range p.resourceFetchers() {
+			if fetcher.selectedBy([]string{name}) {
+				supported = append(supported, name)
+				break
+			}
+		}
 	}
+	return supported
 }
 
 // initializeServices initializes AWS service clients
@@ -404,6 +532,25 @@ func (p *AWSProvider) initializeServices() error {
 	// Initialize VPC service (uses EC2 client)
 	p.vpcService = NewVPCService(ec2Client, p.config, p.logger)
 	
+	// Initialize EBS service (uses EC2 client)
+	p.ebsService = NewEBSService(ec2Client, p.accountID, p.config, p.logger)
+	
+	// Initialize ELB service
+	elbClient := elasticloadbalancingv2.NewFromConfig(p.awsConfig)
+	p.elbService = NewELBService(elbClient, p.config, p.logger)
+	
+	// Initialize Lambda service
+	lambdaClient := lambda.NewFromConfig(p.awsConfig)
+	p.lambdaService = NewLambdaService(lambdaClient, p.config, p.logger)
+	
+	// Initialize ECS service
+	ecsClient := ecs.NewFromConfig(p.awsConfig)
+	p.ecsService = NewECSService(ecsClient, p.config, p.logger)
+	
+	// Initialize DynamoDB service
+	dynamoClient := dynamodb.NewFromConfig(p.awsConfig)
+	p.dynamoService = NewDynamoDBService(dynamoClient, p.config, p.logger)
+	
 	return nil
 }
 
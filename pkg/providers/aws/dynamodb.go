@@ -0,0 +1,336 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Tsahi-Elkayam/cloudview/pkg/config"
+	"github.com/Tsahi-Elkayam/cloudview/pkg/models"
+	shared "github.com/Tsahi-Elkayam/cloudview/pkg/types"
+)
+
+// defaultEncryptionType is reported for tables encrypted with the AWS owned
+// key, which DynamoDB doesn't describe
+const defaultEncryptionType = "DEFAULT"
+
+// DynamoDBService handles DynamoDB-related operations
+type DynamoDBService struct {
+	client *dynamodb.Client
+	config *config.AWSConfig
+	logger *logrus.Logger
+}
+
+// NewDynamoDBService creates a new DynamoDB service
+func NewDynamoDBService(client *dynamodb.Client, cfg *config.AWSConfig, logger *logrus.Logger) *DynamoDBService {
+	return &DynamoDBService{
+		client: client,
+		config: cfg,
+		logger: logger,
+	}
+}
+
+// GetTables retrieves all DynamoDB tables
+func (s *DynamoDBService) GetTables(ctx context.Context, filters shared.ResourceFilters) ([]models.Resource, error) {
+	var allTables []models.Resource
+	
+	// Get regions to query
+	regions := s.getRegionsToQuery(filters.Regions)
+	
+	for _, region := range regions {
+		tables, err := s.getTablesInRegion(ctx, region, filters)
+		if err != nil {
+			s.logger.Errorf("Failed to get DynamoDB tables in region %s: %v", region, err)
+			continue
+		}
+		allTables = append(allTables, tables...)
+	}
+	
+	s.logger.Debugf("Retrieved %d DynamoDB tables", len(allTables))
+	return allTables, nil
+}
+
+// GetTableStatus retrieves the status of a DynamoDB table by name or ARN
+func (s *DynamoDBService) GetTableStatus(ctx context.Context, name string) (*models.ResourceStatus, error) {
+	for _, region := range s.getRegionsToQuery(lookupRegions(name)) {
+		regionClient := s.createRegionClient(region)
+		
+		result, err := regionClient.DescribeTable(ctx, &dynamodb.DescribeTableInput{
+			TableName: aws.String(name),
+		})
+		if hasErrorCode(err, "ResourceNotFoundException", "ValidationException") {
+			continue // Try next region
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to look up DynamoDB table %s in %s: %w", name, region, err)
+		}
+		if result.Table == nil {
+			continue
+		}
+		
+		state := strings.ToLower(string(result.Table.TableStatus))
+		return &models.ResourceStatus{
+			State:       state,
+			Health:      s.mapTableHealth(state, result.Table.Replicas),
+			LastChecked: time.Now(),
+		}, nil
+	}
+	
+	return nil, errNotFound("DynamoDB table", name)
+}
+
+// getTablesInRegion retrieves DynamoDB tables from a specific region
+func (s *DynamoDBService) getTablesInRegion(ctx context.Context, region string, filters shared.ResourceFilters) ([]models.Resource, error) {
+	s.logger.Debugf("Getting DynamoDB tables in region: %s", region)
+	
+	// Create a client for this region
+	regionClient := s.createRegionClient(region)
+	
+	var tables []models.Resource
+	
+	// Use paginator to handle large result sets
+	paginator := dynamodb.NewListTablesPaginator(regionClient, &dynamodb.ListTablesInput{})
+	
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list DynamoDB tables in region %s: %w", region, err)
+		}
+		
+		for _, tableName := range page.TableNames {
+			// Listings only carry names
+			result, err := regionClient.DescribeTable(ctx, &dynamodb.DescribeTableInput{
+				TableName: aws.String(tableName),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to describe DynamoDB table %s in region %s: %w", tableName, region, err)
+			}
+			
+			resource := s.convertTableToResource(*result.Table, region)
+			s.addTableSettings(ctx, regionClient, resource)
+			
+			// Apply additional filters
+			if filters.Matches(resource) {
+				tables = append(tables, *resource)
+			}
+		}
+	}
+	
+	s.logger.Debugf("Found %d DynamoDB tables in region %s", len(tables), region)
+	return tables, nil
+}
+
+// addTableSettings adds the tags, point-in-time recovery and TTL settings
+// that DescribeTable doesn't return
+func (s *DynamoDBService) addTableSettings(ctx context.Context, client *dynamodb.Client, resource *models.Resource) {
+	tags, err := s.getTableTags(ctx, client, resource.ARN)
+	if err != nil {
+		s.logger.Debugf("Failed to get tags for DynamoDB table %s: %v", resource.ID, err)
+	} else {
+		resource.Tags = tags
+	}
+	
+	backups, err := client.DescribeContinuousBackups(ctx, &dynamodb.DescribeContinuousBackupsInput{
+		TableName: aws.String(resource.ID),
+	})
+	if err != nil {
+		s.logger.Debugf("Failed to get continuous backups for DynamoDB table %s: %v", resource.ID, err)
+	} else if backups.ContinuousBackupsDescription != nil && backups.ContinuousBackupsDescription.PointInTimeRecoveryDescription != nil {
+		pitr := backups.ContinuousBackupsDescription.PointInTimeRecoveryDescription
+		resource.SetMetadata("point_in_time_recovery", pitr.PointInTimeRecoveryStatus == types.PointInTimeRecoveryStatusEnabled)
+	}
+	
+	ttl, err := client.DescribeTimeToLive(ctx, &dynamodb.DescribeTimeToLiveInput{
+		TableName: aws.String(resource.ID),
+	})
+	if err != nil {
+		s.logger.Debugf("Failed to get TTL for DynamoDB table %s: %v", resource.ID, err)
+	} else if ttl.TimeToLiveDescription != nil {
+		resource.SetMetadata("ttl_status", strings.ToLower(string(ttl.TimeToLiveDescription.TimeToLiveStatus)))
+		resource.SetMetadata("ttl_attribute", aws.ToString(ttl.TimeToLiveDescription.AttributeName))
+	}
+}
+
+// getTableTags gets the tags of a DynamoDB table
+func (s *DynamoDBService) getTableTags(ctx context.Context, client *dynamodb.Client, tableARN string) (map[string]string, error) {
+	tags := make(map[string]string)
+	
+	// ListTagsOfResource has no paginator
+	input := &dynamodb.ListTagsOfResourceInput{ResourceArn: aws.String(tableARN)}
+	for {
+		result, err := client.ListTagsOfResource(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		for _, tag := range result.Tags {
+			tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+		}
+		if result.NextToken == nil {
+			return tags, nil
+		}
+		input.NextToken = result.NextToken
+	}
+}
+
+// convertTableToResource converts a DynamoDB table to a Resource model
+func (s *DynamoDBService) convertTableToResource(table types.TableDescription, region string) *models.Resource {
+	name := aws.ToString(table.TableName)
+	
+	resource := models.NewResource(
+		name,
+		name,
+		string(models.ResourceTypeDatabase),
+		"aws",
+		region,
+	)
+	resource.NativeType = models.NativeTypeDynamoDBTable
+	resource.ARN = aws.ToString(table.TableArn)
+	
+	// Update status
+	state := strings.ToLower(string(table.TableStatus))
+	resource.UpdateStatus(state, s.mapTableHealth(state, table.Replicas))
+	
+	// Set creation time
+	if table.CreationDateTime != nil {
+		resource.CreatedAt = *table.CreationDateTime
+	}
+	
+	// Add metadata
+	resource.SetMetadata("engine", "dynamodb")
+	resource.SetMetadata("item_count", aws.ToInt64(table.ItemCount))
+	resource.SetMetadata("table_size_bytes", aws.ToInt64(table.TableSizeBytes))
+	resource.SetMetadata("deletion_protection", aws.ToBool(table.DeletionProtectionEnabled))
+	if table.TableClassSummary != nil {
+		resource.SetMetadata("table_class", string(table.TableClassSummary.TableClass))
+	}
+	
+	// Tables created before on-demand billing have no billing mode summary
+	// and are provisioned
+	billingMode := types.BillingModeProvisioned
+	if table.BillingModeSummary != nil && table.BillingModeSummary.BillingMode != "" {
+		billingMode = table.BillingModeSummary.BillingMode
+	}
+	resource.SetMetadata("billing_mode", string(billingMode))
+	if billingMode == types.BillingModeProvisioned && table.ProvisionedThroughput != nil {
+		resource.SetMetadata("read_capacity_units", aws.ToInt64(table.ProvisionedThroughput.ReadCapacityUnits))
+		resource.SetMetadata("write_capacity_units", aws.ToInt64(table.ProvisionedThroughput.WriteCapacityUnits))
+	}
+	
+	// Key schema
+	keys := make(map[string]string, len(table.KeySchema))
+	for _, key := range table.KeySchema {
+		keys[strings.ToLower(string(key.KeyType))] = aws.ToString(key.AttributeName)
+	}
+	resource.SetMetadata("partition_key", keys["hash"])
+	resource.SetMetadata("sort_key", keys["range"])
+	
+	// Secondary indexes
+	var globalIndexes []map[string]interface{}
+	for _, index := range table.GlobalSecondaryIndexes {
+		globalIndex := map[string]interface{}{
+			"name":       aws.ToString(index.IndexName),
+			"status":     strings.ToLower(string(index.IndexStatus)),
+			"item_count": aws.ToInt64(index.ItemCount),
+			"size_bytes": aws.ToInt64(index.IndexSizeBytes),
+		}
+		if billingMode == types.BillingModeProvisioned && index.ProvisionedThroughput != nil {
+			globalIndex["read_capacity_units"] = aws.ToInt64(index.ProvisionedThroughput.ReadCapacityUnits)
+			globalIndex["write_capacity_units"] = aws.ToInt64(index.ProvisionedThroughput.WriteCapacityUnits)
+		}
+		globalIndexes = append(globalIndexes, globalIndex)
+	}
+	resource.SetMetadata("global_secondary_indexes", globalIndexes)
+	
+	var localIndexes []map[string]interface{}
+	for _, index := range table.LocalSecondaryIndexes {
+		localIndexes = append(localIndexes, map[string]interface{}{
+			"name":       aws.ToString(index.IndexName),
+			"item_count": aws.ToInt64(index.ItemCount),
+			"size_bytes": aws.ToInt64(index.IndexSizeBytes),
+		})
+	}
+	resource.SetMetadata("local_secondary_indexes", localIndexes)
+	
+	// Streams
+	streamEnabled := table.StreamSpecification != nil && aws.ToBool(table.StreamSpecification.StreamEnabled)
+	resource.SetMetadata("stream_enabled", streamEnabled)
+	if streamEnabled {
+		resource.SetMetadata("stream_view_type", string(table.StreamSpecification.StreamViewType))
+		resource.SetMetadata("stream_arn", aws.ToString(table.LatestStreamArn))
+	}
+	
+	// Encryption at rest is always on
+	encryptionType := defaultEncryptionType
+	if table.SSEDescription != nil && table.SSEDescription.SSEType != "" {
+		encryptionType = string(table.SSEDescription.SSEType)
+		resource.SetMetadata("kms_key_arn", aws.ToString(table.SSEDescription.KMSMasterKeyArn))
+	}
+	resource.SetMetadata("encryption_type", encryptionType)
+	
+	// Global table replicas
+	var replicas []string
+	for _, replica := range table.Replicas {
+		replicas = append(replicas, fmt.Sprintf("%s (%s)", aws.ToString(replica.RegionName), strings.ToLower(string(replica.ReplicaStatus))))
+	}
+	resource.SetMetadata("replicas", replicas)
+	resource.SetMetadata("global_table_version", aws.ToString(table.GlobalTableVersion))
+	
+	return resource
+}
+
+// mapTableHealth maps DynamoDB table status to resource health. An active
+// global table is in warning while any of its replicas isn't active.
+func (s *DynamoDBService) mapTableHealth(status string, replicas []types.ReplicaDescription) string {
+	switch status {
+	case "active":
+		for _, replica := range replicas {
+			if replica.ReplicaStatus != types.ReplicaStatusActive {
+				return string(models.HealthWarning)
+			}
+		}
+		return string(models.HealthHealthy)
+	case "creating", "updating", "deleting", "archiving", "archived":
+		return string(models.HealthWarning)
+	case "inaccessible_encryption_credentials":
+		return string(models.HealthUnhealthy)
+	default:
+		return string(models.HealthUnknown)
+	}
+}
+
+// getRegionsToQuery determines which regions to query based on filters and config
+func (s *DynamoDBService) getRegionsToQuery(filterRegions []string) []string {
+	// If specific regions are requested via filters, use those
+	if len(filterRegions) > 0 {
+		return filterRegions
+	}
+	
+	// If regions are configured, use those
+	configRegions := s.config.GetRegions()
+	if len(configRegions) > 0 {
+		return configRegions
+	}
+	
+	// Fallback to primary region if no regions specified
+	if s.config.Region != "" {
+		return []string{s.config.Region}
+	}
+	
+	// Ultimate fallback to us-east-1
+	return []string{"us-east-1"}
+}
+
+// createRegionClient creates a DynamoDB client for a specific region
+func (s *DynamoDBService) createRegionClient(region string) *dynamodb.Client {
+	// Create a new config with the specific region
+	cfg := s.client.Options()
+	cfg.Region = region
+	
+	return dynamodb.New(cfg)
+}
@@ -0,0 +1,124 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Tsahi-Elkayam/cloudview/pkg/config"
+	"github.com/Tsahi-Elkayam/cloudview/pkg/models"
+)
+
+func TestConvertTableToResource(t *testing.T) {
+	service := NewDynamoDBService(nil, &config.AWSConfig{Region: "us-east-1"}, logrus.New())
+
+	resource := service.convertTableToResource(types.TableDescription{
+		TableName:      aws.String("orders"),
+		TableArn:       aws.String("arn:aws:dynamodb:us-east-1:123456789012:table/orders"),
+		TableStatus:    types.TableStatusActive,
+		ItemCount:      aws.Int64(1200),
+		TableSizeBytes: aws.Int64(65536),
+		KeySchema: []types.KeySchemaElement{
+			{AttributeName: aws.String("customer_id"), KeyType: types.KeyTypeHash},
+			{AttributeName: aws.String("order_id"), KeyType: types.KeyTypeRange},
+		},
+		ProvisionedThroughput: &types.ProvisionedThroughputDescription{
+			ReadCapacityUnits:  aws.Int64(10),
+			WriteCapacityUnits: aws.Int64(5),
+		},
+		GlobalSecondaryIndexes: []types.GlobalSecondaryIndexDescription{
+			{IndexName: aws.String("by-status"), IndexStatus: types.IndexStatusActive},
+		},
+		StreamSpecification: &types.StreamSpecification{
+			StreamEnabled:  aws.Bool(true),
+			StreamViewType: types.StreamViewTypeNewAndOldImages,
+		},
+		SSEDescription: &types.SSEDescription{
+			SSEType:         types.SSETypeKms,
+			KMSMasterKeyArn: aws.String("arn:aws:kms:us-east-1:123456789012:key/1"),
+		},
+		Replicas: []types.ReplicaDescription{
+			{RegionName: aws.String("eu-west-1"), ReplicaStatus: types.ReplicaStatusActive},
+		},
+	}, "us-east-1")
+
+	assert.Equal(t, "orders", resource.ID)
+	assert.Equal(t, string(models.ResourceTypeDatabase), resource.Type)
+	assert.Equal(t, models.NativeTypeDynamoDBTable, resource.NativeType)
+	assert.Equal(t, "arn:aws:dynamodb:us-east-1:123456789012:table/orders", resource.ARN)
+	assert.Equal(t, "active", resource.Status.State)
+	assert.Equal(t, string(models.HealthHealthy), resource.Status.Health)
+
+	assert.Equal(t, "PROVISIONED", resource.Metadata["billing_mode"])
+	assert.Equal(t, int64(10), resource.Metadata["read_capacity_units"])
+	assert.Equal(t, int64(5), resource.Metadata["write_capacity_units"])
+	assert.Equal(t, int64(1200), resource.Metadata["item_count"])
+	assert.Equal(t, "customer_id", resource.Metadata["partition_key"])
+	assert.Equal(t, "order_id", resource.Metadata["sort_key"])
+	assert.Equal(t, true, resource.Metadata["stream_enabled"])
+	assert.Equal(t, "NEW_AND_OLD_IMAGES", resource.Metadata["stream_view_type"])
+	assert.Equal(t, "KMS", resource.Metadata["encryption_type"])
+	assert.Equal(t, []string{"eu-west-1 (active)"}, resource.Metadata["replicas"])
+	assert.Len(t, resource.Metadata["global_secondary_indexes"], 1)
+}
+
+func TestConvertOnDemandTableToResource(t *testing.T) {
+	service := NewDynamoDBService(nil, &config.AWSConfig{Region: "us-east-1"}, logrus.New())
+
+	resource := service.convertTableToResource(types.TableDescription{
+		TableName:             aws.String("sessions"),
+		TableStatus:           types.TableStatusActive,
+		BillingModeSummary:    &types.BillingModeSummary{BillingMode: types.BillingModePayPerRequest},
+		ProvisionedThroughput: &types.ProvisionedThroughputDescription{ReadCapacityUnits: aws.Int64(0)},
+	}, "us-east-1")
+
+	assert.Equal(t, "PAY_PER_REQUEST", resource.Metadata["billing_mode"])
+	assert.NotContains(t, resource.Metadata, "read_capacity_units")
+	assert.Equal(t, false, resource.Metadata["stream_enabled"])
+	assert.Equal(t, defaultEncryptionType, resource.Metadata["encryption_type"])
+}
+
+func TestMapTableHealth(t *testing.T) {
+	service := NewDynamoDBService(nil, &config.AWSConfig{}, logrus.New())
+
+	creatingReplica := []types.ReplicaDescription{{ReplicaStatus: types.ReplicaStatusCreating}}
+
+	tests := []struct {
+		name     string
+		status   string
+		replicas []types.ReplicaDescription
+		want     models.ResourceHealth
+	}{
+		{"active", "active", nil, models.HealthHealthy},
+		{"replica not active", "active", creatingReplica, models.HealthWarning},
+		{"updating", "updating", nil, models.HealthWarning},
+		{"lost key", "inaccessible_encryption_credentials", nil, models.HealthUnhealthy},
+		{"unknown", "", nil, models.HealthUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, string(tt.want), service.mapTableHealth(tt.status, tt.replicas))
+		})
+	}
+}
+
+func TestDynamoDBFetcherSelection(t *testing.T) {
+	provider, err := NewAWSProvider(&config.AWSConfig{Region: "us-east-1"}, nil)
+	assert.NoError(t, err)
+
+	var dynamoFetcher resourceFetcher
+	for _, fetcher := range provider.resourceFetchers() {
+		if fetcher.nativeType == models.NativeTypeDynamoDBTable {
+			dynamoFetcher = fetcher
+		}
+	}
+
+	for _, name := range []string{"dynamodb", "database", "databases", "AWS::DynamoDB::Table"} {
+		assert.True(t, dynamoFetcher.selectedBy([]string{name}), name)
+	}
+	assert.False(t, dynamoFetcher.selectedBy([]string{"rds"}))
+}
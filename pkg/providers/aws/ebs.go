@@ -0,0 +1,386 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Tsahi-Elkayam/cloudview/pkg/config"
+	"github.com/Tsahi-Elkayam/cloudview/pkg/models"
+	shared "github.com/Tsahi-Elkayam/cloudview/pkg/types"
+)
+
+// EBSService handles EBS volume and snapshot operations
+type EBSService struct {
+	client    *ec2.Client
+	accountID string
+	config    *config.AWSConfig
+	logger    *logrus.Logger
+}
+
+// NewEBSService creates a new EBS service. Volume listings don't include
+// their owner, so the account ID is needed to build volume ARNs.
+func NewEBSService(client *ec2.Client, accountID string, cfg *config.AWSConfig, logger *logrus.Logger) *EBSService {
+	return &EBSService{
+		client:    client,
+		accountID: accountID,
+		config:    cfg,
+		logger:    logger,
+	}
+}
+
+// GetVolumes retrieves all EBS volumes
+func (s *EBSService) GetVolumes(ctx context.Context, filters shared.ResourceFilters) ([]models.Resource, error) {
+	var allVolumes []models.Resource
+	
+	// Get regions to query
+	regions := s.getRegionsToQuery(filters.Regions)
+	
+	for _, region := range regions {
+		volumes, err := s.getVolumesInRegion(ctx, region, filters)
+		if err != nil {
+			s.logger.Errorf("Failed to get EBS volumes in region %s: %v", region, err)
+			continue
+		}
+		allVolumes = append(allVolumes, volumes...)
+	}
+	
+	s.logger.Debugf("Retrieved %d EBS volumes", len(allVolumes))
+	return allVolumes, nil
+}
+
+// GetSnapshots retrieves all EBS snapshots owned by the account
+func (s *EBSService) GetSnapshots(ctx context.Context, filters shared.ResourceFilters) ([]models.Resource, error) {
+	var allSnapshots []models.Resource
+	
+	// Get regions to query
+	regions := s.getRegionsToQuery(filters.Regions)
+	
+	for _, region := range regions {
+		snapshots, err := s.getSnapshotsInRegion(ctx, region, filters)
+		if err != nil {
+			s.logger.Errorf("Failed to get EBS snapshots in region %s: %v", region, err)
+			continue
+		}
+		allSnapshots = append(allSnapshots, snapshots...)
+	}
+	
+	s.logger.Debugf("Retrieved %d EBS snapshots", len(allSnapshots))
+	return allSnapshots, nil
+}
+
+// GetVolumeStatus retrieves the status of an EBS volume by ID or ARN
+func (s *EBSService) GetVolumeStatus(ctx context.Context, volumeID string) (*models.ResourceStatus, error) {
+	regions := s.getRegionsToQuery(lookupRegions(volumeID))
+	volumeID = arnResourceName(volumeID)
+	
+	for _, region := range regions {
+		regionClient := s.createRegionClient(region)
+		
+		result, err := regionClient.DescribeVolumes(ctx, &ec2.DescribeVolumesInput{
+			VolumeIds: []string{volumeID},
+		})
+		if hasErrorCode(err, "InvalidVolume.NotFound", "InvalidVolumeID.Malformed") {
+			continue // Try next region
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to look up EBS volume %s in %s: %w", volumeID, region, err)
+		}
+		if len(result.Volumes) == 0 {
+			continue
+		}
+		
+		state := result.Volumes[0].State
+		return &models.ResourceStatus{
+			State:       string(state),
+			Health:      s.mapVolumeStateToHealth(state),
+			LastChecked: time.Now(),
+		}, nil
+	}
+	
+	return nil, errNotFound("EBS volume", volumeID)
+}
+
+// GetSnapshotStatus retrieves the status of an EBS snapshot by ID or ARN
+func (s *EBSService) GetSnapshotStatus(ctx context.Context, snapshotID string) (*models.ResourceStatus, error) {
+	regions := s.getRegionsToQuery(lookupRegions(snapshotID))
+	snapshotID = arnResourceName(snapshotID)
+	
+	for _, region := range regions {
+		regionClient := s.createRegionClient(region)
+		
+		result, err := regionClient.DescribeSnapshots(ctx, &ec2.DescribeSnapshotsInput{
+			SnapshotIds: []string{snapshotID},
+		})
+		if hasErrorCode(err, "InvalidSnapshot.NotFound", "InvalidSnapshotID.Malformed") {
+			continue // Try next region
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to look up EBS snapshot %s in %s: %w", snapshotID, region, err)
+		}
+		if len(result.Snapshots) == 0 {
+			continue
+		}
+		
+		state := result.Snapshots[0].State
+		return &models.ResourceStatus{
+			State:       string(state),
+			Health:      s.mapSnapshotStateToHealth(state),
+			LastChecked: time.Now(),
+		}, nil
+	}
+	
+	return nil, errNotFound("EBS snapshot", snapshotID)
+}
+
+// getVolumesInRegion retrieves EBS volumes from a specific region
+func (s *EBSService) getVolumesInRegion(ctx context.Context, region string, filters shared.ResourceFilters) ([]models.Resource, error) {
+	s.logger.Debugf("Getting EBS volumes in region: %s", region)
+	
+	// Create a client for this region
+	regionClient := s.createRegionClient(region)
+	
+	var volumes []models.Resource
+	
+	// Use paginator to handle large result sets
+	paginator := ec2.NewDescribeVolumesPaginator(regionClient, &ec2.DescribeVolumesInput{})
+	
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe volumes in region %s: %w", region, err)
+		}
+		
+		for _, volume := range page.Volumes {
+			resource := s.convertVolumeToResource(volume, region)
+			
+			// Apply additional filters
+			if filters.Matches(resource) {
+				volumes = append(volumes, *resource)
+			}
+		}
+	}
+	
+	s.logger.Debugf("Found %d EBS volumes in region %s", len(volumes), region)
+	return volumes, nil
+}
+
+// getSnapshotsInRegion retrieves the account's EBS snapshots from a specific region
+func (s *EBSService) getSnapshotsInRegion(ctx context.Context, region string, filters shared.ResourceFilters) ([]models.Resource, error) {
+	s.logger.Debugf("Getting EBS snapshots in region: %s", region)
+	
+	// Create a client for this region
+	regionClient := s.createRegionClient(region)
+	
+	// Without an owner, public and shared snapshots are listed too
+	input := &ec2.DescribeSnapshotsInput{
+		OwnerIds: []string{"self"},
+	}
+	
+	var snapshots []models.Resource
+	
+	// Use paginator to handle large result sets
+	paginator := ec2.NewDescribeSnapshotsPaginator(regionClient, input)
+	
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe snapshots in region %s: %w", region, err)
+		}
+		
+		for _, snapshot := range page.Snapshots {
+			resource := s.convertSnapshotToResource(snapshot, region)
+			
+			// Apply additional filters
+			if filters.Matches(resource) {
+				snapshots = append(snapshots, *resource)
+			}
+		}
+	}
+	
+	s.logger.Debugf("Found %d EBS snapshots in region %s", len(snapshots), region)
+	return snapshots, nil
+}
+
+// convertVolumeToResource converts an EBS volume to a Resource model
+func (s *EBSService) convertVolumeToResource(volume types.Volume, region string) *models.Resource {
+	volumeID := aws.ToString(volume.VolumeId)
+	
+	resource := models.NewResource(
+		volumeID,
+		tagName(volume.Tags, volumeID),
+		string(models.ResourceTypeBlockStorage),
+		"aws",
+		region,
+	)
+	resource.NativeType = models.NativeTypeEBSVolume
+	resource.ARN = buildARN("ec2", region, s.accountID, "volume/"+volumeID)
+	
+	// Update status
+	resource.UpdateStatus(
+		string(volume.State),
+		s.mapVolumeStateToHealth(volume.State),
+	)
+	
+	// Set tags
+	resource.Tags = convertTags(volume.Tags)
+	
+	// Set creation time
+	if volume.CreateTime != nil {
+		resource.CreatedAt = *volume.CreateTime
+	}
+	
+	// Add metadata
+	resource.SetMetadata("size_gb", aws.ToInt32(volume.Size))
+	resource.SetMetadata("volume_type", string(volume.VolumeType))
+	resource.SetMetadata("iops", aws.ToInt32(volume.Iops))
+	resource.SetMetadata("throughput", aws.ToInt32(volume.Throughput))
+	resource.SetMetadata("encrypted", aws.ToBool(volume.Encrypted))
+	resource.SetMetadata("kms_key_id", aws.ToString(volume.KmsKeyId))
+	resource.SetMetadata("availability_zone", aws.ToString(volume.AvailabilityZone))
+	resource.SetMetadata("snapshot_id", aws.ToString(volume.SnapshotId))
+	resource.SetMetadata("multi_attach_enabled", aws.ToBool(volume.MultiAttachEnabled))
+	
+	// Attachments; an unattached volume still costs money
+	attachmentState := string(types.VolumeAttachmentStateDetached)
+	// Multi-attach volumes can be attached to several instances
+	instanceIDs := []string{}
+	for _, attachment := range volume.Attachments {
+		attachmentState = string(attachment.State)
+		instanceIDs = append(instanceIDs, aws.ToString(attachment.InstanceId))
+	}
+	resource.SetMetadata("attachment_state", attachmentState)
+	resource.SetMetadata("attached_instances", instanceIDs)
+	if len(volume.Attachments) == 1 {
+		resource.SetMetadata("device", aws.ToString(volume.Attachments[0].Device))
+		resource.SetMetadata("delete_on_termination", aws.ToBool(volume.Attachments[0].DeleteOnTermination))
+	}
+	
+	return resource
+}
+
+// convertSnapshotToResource converts an EBS snapshot to a Resource model
+func (s *EBSService) convertSnapshotToResource(snapshot types.Snapshot, region string) *models.Resource {
+	snapshotID := aws.ToString(snapshot.SnapshotId)
+	
+	resource := models.NewResource(
+		snapshotID,
+		tagName(snapshot.Tags, snapshotID),
+		string(models.ResourceTypeBlockStorage),
+		"aws",
+		region,
+	)
+	resource.NativeType = models.NativeTypeEBSSnapshot
+	// Snapshot ARNs have no account ID
+	resource.ARN = buildARN("ec2", region, "", "snapshot/"+snapshotID)
+	
+	// Update status
+	resource.UpdateStatus(
+		string(snapshot.State),
+		s.mapSnapshotStateToHealth(snapshot.State),
+	)
+	
+	// Set tags
+	resource.Tags = convertTags(snapshot.Tags)
+	
+	// Set creation time
+	if snapshot.StartTime != nil {
+		resource.CreatedAt = *snapshot.StartTime
+		resource.SetMetadata("age_days", int(time.Since(*snapshot.StartTime).Hours()/24))
+	}
+	
+	// Add metadata
+	resource.SetMetadata("description", aws.ToString(snapshot.Description))
+	resource.SetMetadata("volume_id", aws.ToString(snapshot.VolumeId))
+	resource.SetMetadata("volume_size_gb", aws.ToInt32(snapshot.VolumeSize))
+	resource.SetMetadata("storage_tier", string(snapshot.StorageTier))
+	resource.SetMetadata("progress", aws.ToString(snapshot.Progress))
+	resource.SetMetadata("encrypted", aws.ToBool(snapshot.Encrypted))
+	resource.SetMetadata("kms_key_id", aws.ToString(snapshot.KmsKeyId))
+	resource.SetMetadata("owner_id", aws.ToString(snapshot.OwnerId))
+	
+	return resource
+}
+
+// mapVolumeStateToHealth maps EBS volume state to resource health
+func (s *EBSService) mapVolumeStateToHealth(state types.VolumeState) string {
+	switch state {
+	case types.VolumeStateInUse, types.VolumeStateAvailable:
+		return string(models.HealthHealthy)
+	case types.VolumeStateCreating, types.VolumeStateDeleting:
+		return string(models.HealthWarning)
+	case types.VolumeStateError:
+		return string(models.HealthUnhealthy)
+	default:
+		return string(models.HealthUnknown)
+	}
+}
+
+// mapSnapshotStateToHealth maps EBS snapshot state to resource health
+func (s *EBSService) mapSnapshotStateToHealth(state types.SnapshotState) string {
+	switch state {
+	case types.SnapshotStateCompleted:
+		return string(models.HealthHealthy)
+	case types.SnapshotStatePending, types.SnapshotStateRecovering, types.SnapshotStateRecoverable:
+		return string(models.HealthWarning)
+	case types.SnapshotStateError:
+		return string(models.HealthUnhealthy)
+	default:
+		return string(models.HealthUnknown)
+	}
+}
+
+// getRegionsToQuery determines which regions to query based on filters and config
+func (s *EBSService) getRegionsToQuery(filterRegions []string) []string {
+	// If specific regions are requested via filters, use those
+	if len(filterRegions) > 0 {
+		return filterRegions
+	}
+	
+	// If regions are configured, use those
+	configRegions := s.config.GetRegions()
+	if len(configRegions) > 0 {
+		return configRegions
+	}
+	
+	// Fallback to primary region if no regions specified
+	if s.config.Region != "" {
+		return []string{s.config.Region}
+	}
+	
+	// Ultimate fallback to us-east-1
+	return []string{"us-east-1"}
+}
+
+// createRegionClient creates an EC2 client for a specific region
+func (s *EBSService) createRegionClient(region string) *ec2.Client {
+	// Create a new config with the specific region
+	cfg := s.client.Options()
+	cfg.Region = region
+	
+	return ec2.New(cfg)
+}
+
+// tagName returns the value of an EC2 resource's Name tag, or fallback if it has none
+func tagName(tags []types.Tag, fallback string) string {
+	for _, tag := range tags {
+		if aws.ToString(tag.Key) == "Name" {
+			return aws.ToString(tag.Value)
+		}
+	}
+	return fallback
+}
+
+// convertTags converts EC2 tags to a map
+func convertTags(tags []types.Tag) map[string]string {
+	converted := make(map[string]string, len(tags))
+	for _, tag := range tags {
+		converted[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+	return converted
+}
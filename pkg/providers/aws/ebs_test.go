@@ -0,0 +1,86 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Tsahi-Elkayam/cloudview/pkg/config"
+	"github.com/Tsahi-Elkayam/cloudview/pkg/models"
+)
+
+func TestConvertVolumeToResource(t *testing.T) {
+	service := NewEBSService(nil, "123456789012", &config.AWSConfig{Region: "us-east-1"}, logrus.New())
+
+	tests := []struct {
+		name            string
+		attachments     []types.VolumeAttachment
+		attachmentState string
+		instanceIDs     []string
+	}{
+		{"unattached", nil, "detached", []string{}},
+		{"attached", []types.VolumeAttachment{{
+			InstanceId: aws.String("i-1"),
+			State:      types.VolumeAttachmentStateAttached,
+			Device:     aws.String("/dev/xvda"),
+		}}, "attached", []string{"i-1"}},
+		{"multi-attached", []types.VolumeAttachment{
+			{InstanceId: aws.String("i-1"), State: types.VolumeAttachmentStateAttached},
+			{InstanceId: aws.String("i-2"), State: types.VolumeAttachmentStateAttached},
+		}, "attached", []string{"i-1", "i-2"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := service.convertVolumeToResource(types.Volume{
+				VolumeId:    aws.String("vol-1"),
+				Size:        aws.Int32(100),
+				VolumeType:  types.VolumeTypeGp3,
+				Iops:        aws.Int32(3000),
+				Throughput:  aws.Int32(125),
+				Encrypted:   aws.Bool(true),
+				KmsKeyId:    aws.String("arn:aws:kms:us-east-1:123456789012:key/abc"),
+				State:       types.VolumeStateAvailable,
+				Attachments: tt.attachments,
+				Tags:        []types.Tag{{Key: aws.String("Name"), Value: aws.String("data")}},
+			}, "us-east-1")
+
+			assert.Equal(t, "vol-1", resource.ID)
+			assert.Equal(t, "data", resource.Name)
+			assert.Equal(t, string(models.ResourceTypeBlockStorage), resource.Type)
+			assert.Equal(t, models.NativeTypeEBSVolume, resource.NativeType)
+			assert.Equal(t, "arn:aws:ec2:us-east-1:123456789012:volume/vol-1", resource.ARN)
+			assert.Equal(t, int32(100), resource.Metadata["size_gb"])
+			assert.Equal(t, "gp3", resource.Metadata["volume_type"])
+			assert.Equal(t, true, resource.Metadata["encrypted"])
+			assert.Equal(t, tt.attachmentState, resource.Metadata["attachment_state"])
+			assert.Equal(t, tt.instanceIDs, resource.Metadata["attached_instances"])
+		})
+	}
+}
+
+func TestConvertSnapshotToResource(t *testing.T) {
+	service := NewEBSService(nil, "123456789012", &config.AWSConfig{Region: "us-east-1"}, logrus.New())
+	started := time.Now().Add(-400 * 24 * time.Hour)
+
+	resource := service.convertSnapshotToResource(types.Snapshot{
+		SnapshotId: aws.String("snap-1"),
+		VolumeId:   aws.String("vol-1"),
+		VolumeSize: aws.Int32(100),
+		StartTime:  aws.Time(started),
+		State:      types.SnapshotStateCompleted,
+		OwnerId:    aws.String("123456789012"),
+	}, "eu-west-1")
+
+	assert.Equal(t, "snap-1", resource.Name)
+	assert.Equal(t, models.NativeTypeEBSSnapshot, resource.NativeType)
+	assert.Equal(t, "arn:aws:ec2:eu-west-1::snapshot/snap-1", resource.ARN)
+	assert.Equal(t, string(models.HealthHealthy), resource.Status.Health)
+	assert.Equal(t, started, resource.CreatedAt)
+	assert.Equal(t, "vol-1", resource.Metadata["volume_id"])
+	assert.Equal(t, 400, resource.Metadata["age_days"])
+}
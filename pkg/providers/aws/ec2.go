@@ -52,10 +52,14 @@ func (s *EC2Service) GetInstances(ctx context.Context, filters shared.ResourceFi
 	return allInstances, nil
 }
 
-// GetInstanceStatus retrieves the status of a specific EC2 instance
+// GetInstanceStatus retrieves the status of a specific EC2 instance by ID or ARN
 func (s *EC2Service) GetInstanceStatus(ctx context.Context, instanceID string) (*models.ResourceStatus, error) {
-	// Try to find the instance in all configured regions
-	regions := s.config.GetRegions()
+	// Try to find the instance in the ARN's region or all configured regions
+	regions := lookupRegions(instanceID)
+	if len(regions) == 0 {
+		regions = s.config.GetRegions()
+	}
+	instanceID = arnResourceName(instanceID)
 	
 	for _, region := range regions {
 		// Create a client for this region
@@ -66,9 +70,12 @@ func (s *EC2Service) GetInstanceStatus(ctx context.Context, instanceID string) (
 		}
 		
 		result, err := regionClient.DescribeInstances(ctx, input)
-		if err != nil {
+		if hasErrorCode(err, "InvalidInstanceID.NotFound", "InvalidInstanceID.Malformed") {
 			continue // Try next region
 		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to look up EC2 instance %s in %s: %w", instanceID, region, err)
+		}
 		
 		for _, reservation := range result.Reservations {
 			for _, instance := range reservation.Instances {
@@ -83,7 +90,7 @@ func (s *EC2Service) GetInstanceStatus(ctx context.Context, instanceID string) (
 		}
 	}
 	
-	return nil, fmt.Errorf("EC2 instance %s not found", instanceID)
+	return nil, errNotFound("EC2 instance", instanceID)
 }
 
 // getInstancesInRegion retrieves instances from a specific region
@@ -113,10 +120,10 @@ func (s *EC2Service) getInstancesInRegion(ctx context.Context, region string, fi
 		
 		for _, reservation := range page.Reservations {
 			for _, instance := range reservation.Instances {
-				resource := s.convertInstanceToResource(instance, region)
+				resource := s.convertInstanceToResource(instance, region, aws.ToString(reservation.OwnerId))
 				
 				// Apply additional filters
-				if s.matchesFilters(resource, filters) {
+				if filters.Matches(resource) {
 					instances = append(instances, *resource)
 				}
 			}
@@ -128,7 +135,7 @@ func (s *EC2Service) getInstancesInRegion(ctx context.Context, region string, fi
 }
 
 // convertInstanceToResource converts an EC2 instance to a Resource model
-func (s *EC2Service) convertInstanceToResource(instance types.Instance, region string) *models.Resource {
+func (s *EC2Service) convertInstanceToResource(instance types.Instance, region, ownerID string) *models.Resource {
 	// Get instance name from tags
 	name := aws.ToString(instance.InstanceId)
 	for _, tag := range instance.Tags {
@@ -152,6 +159,8 @@ func (s *EC2Service) convertInstanceToResource(instance types.Instance, region s
 		"aws",
 		region,
 	)
+	resource.NativeType = models.NativeTypeEC2Instance
+	resource.ARN = buildARN("ec2", region, ownerID, "instance/"+aws.ToString(instance.InstanceId))
 	
 	// Update status
 	resource.UpdateStatus(
@@ -196,8 +205,9 @@ func (s *EC2Service) convertInstanceToResource(instance types.Instance, region s
 func (s *EC2Service) buildEC2Filters(filters shared.ResourceFilters) []types.Filter {
 	var ec2Filters []types.Filter
 	
-	// Filter by instance state
-	if len(filters.Status) > 0 {
+	// Filter by instance state. Statuses that aren't EC2 state names (such as
+	// "active") are matched after normalization in filters.Matches instead.
+	if len(filters.Status) > 0 && isEC2StateNames(filters.Status) {
 		ec2Filters = append(ec2Filters, types.Filter{
 			Name:   aws.String("instance-state-name"),
 			Values: filters.Status,
@@ -212,16 +222,42 @@ func (s *EC2Service) buildEC2Filters(filters shared.ResourceFilters) []types.Fil
 		})
 	}
 	
+	// Filter by tag existence and values. EC2 understands * and ? wildcards;
+	// absence and regular expressions are only checked in matchesFilters.
+	for _, tagFilter := range filters.TagFilters {
+		switch {
+		case tagFilter.Absent || tagFilter.Regex != "":
+			continue
+		case len(tagFilter.Values) == 0:
+			ec2Filters = append(ec2Filters, types.Filter{
+				Name:   aws.String("tag-key"),
+				Values: []string{tagFilter.Key},
+			})
+		default:
+			ec2Filters = append(ec2Filters, types.Filter{
+				Name:   aws.String(fmt.Sprintf("tag:%s", tagFilter.Key)),
+				Values: tagFilter.Values,
+			})
+		}
+	}
+	
+	// Push down filter expression conditions EC2 can evaluate server-side.
+	// The full expression is still checked in matchesFilters.
+	for _, term := range filters.Expression.Terms() {
+		if filter, ok := ec2FilterForTerm(term); ok {
+			ec2Filters = append(ec2Filters, filter)
+		}
+	}
+	
 	return ec2Filters
 }
 
-// matchesFilters checks if a resource matches the given filters
-func (s *EC2Service) matchesFilters(resource *models.Resource, filters shared.ResourceFilters) bool {
-	// Check resource type filter
-	if len(filters.ResourceTypes) > 0 {
+// isEC2StateNames reports whether every status is an EC2 instance state name
+func isEC2StateNames(statuses []string) bool {
+	for _, status := range statuses {
 		found := false
-		for _, rt := range filters.ResourceTypes {
-			if strings.EqualFold(rt, "ec2") || strings.EqualFold(rt, "instance") || strings.EqualFold(rt, "virtual_machine") {
+		for _, state := range types.InstanceStateNameRunning.Values() {
+			if string(state) == status {
 				found = true
 				break
 			}
@@ -230,17 +266,40 @@ func (s *EC2Service) matchesFilters(resource *models.Resource, filters shared.Re
 			return false
 		}
 	}
-	
-	// Check creation time filters
-	if filters.CreatedAfter != nil && resource.CreatedAt.Before(*filters.CreatedAfter) {
-		return false
+	return true
+}
+
+// ec2ExpressionFilters maps resource fields to DescribeInstances filter names
+var ec2ExpressionFilters = map[string]string{
+	"id":                         "instance-id",
+	"status.state":               "instance-state-name",
+	"metadata.instance_type":     "instance-type",
+	"metadata.vpc_id":            "vpc-id",
+	"metadata.subnet_id":         "subnet-id",
+	"metadata.availability_zone": "availability-zone",
+	"metadata.image_id":          "image-id",
+	"metadata.key_name":          "key-name",
+	"metadata.private_ip":        "private-ip-address",
+	"metadata.public_ip":         "ip-address",
+	"metadata.security_groups":   "instance.group-id",
+}
+
+// ec2FilterForTerm converts a filter expression term to an EC2 API filter
+func ec2FilterForTerm(term shared.FilterTerm) (types.Filter, bool) {
+	if strings.HasPrefix(term.Field, "tags.") {
+		key := strings.TrimPrefix(term.Field, "tags.")
+		if term.Exists {
+			return types.Filter{Name: aws.String("tag-key"), Values: []string{key}}, true
+		}
+		return types.Filter{Name: aws.String("tag:" + key), Values: term.Values}, true
 	}
 	
-	if filters.CreatedBefore != nil && resource.CreatedAt.After(*filters.CreatedBefore) {
-		return false
+	name, ok := ec2ExpressionFilters[term.Field]
+	if !ok || term.Exists {
+		return types.Filter{}, false
 	}
 	
-	return true
+	return types.Filter{Name: aws.String(name), Values: term.Values}, true
 }
 
 // mapInstanceHealthToHealth maps EC2 instance state to resource health
@@ -0,0 +1,671 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Tsahi-Elkayam/cloudview/pkg/config"
+	"github.com/Tsahi-Elkayam/cloudview/pkg/models"
+	shared "github.com/Tsahi-Elkayam/cloudview/pkg/types"
+)
+
+// Maximum number of resources a single ECS describe call accepts
+const (
+	ecsDescribeClustersLimit = 100
+	ecsDescribeServicesLimit = 10
+	ecsDescribeTasksLimit    = 100
+)
+
+// ECSService handles ECS cluster, service and task operations
+type ECSService struct {
+	client *ecs.Client
+	config *config.AWSConfig
+	logger *logrus.Logger
+}
+
+// NewECSService creates a new ECS service
+func NewECSService(client *ecs.Client, cfg *config.AWSConfig, logger *logrus.Logger) *ECSService {
+	return &ECSService{
+		client: client,
+		config: cfg,
+		logger: logger,
+	}
+}
+
+// GetClusters retrieves all ECS clusters
+func (s *ECSService) GetClusters(ctx context.Context, filters shared.ResourceFilters) ([]models.Resource, error) {
+	var allClusters []models.Resource
+	
+	// Get regions to query
+	regions := s.getRegionsToQuery(filters.Regions)
+	
+	for _, region := range regions {
+		clusters, err := s.getClustersInRegion(ctx, region, filters)
+		if err != nil {
+			s.logger.Errorf("Failed to get ECS clusters in region %s: %v", region, err)
+			continue
+		}
+		allClusters = append(allClusters, clusters...)
+	}
+	
+	s.logger.Debugf("Retrieved %d ECS clusters", len(allClusters))
+	return allClusters, nil
+}
+
+// GetServices retrieves the services of all ECS clusters
+func (s *ECSService) GetServices(ctx context.Context, filters shared.ResourceFilters) ([]models.Resource, error) {
+	var allServices []models.Resource
+	
+	// Get regions to query
+	regions := s.getRegionsToQuery(filters.Regions)
+	
+	for _, region := range regions {
+		services, err := s.getServicesInRegion(ctx, region, filters)
+		if err != nil {
+			s.logger.Errorf("Failed to get ECS services in region %s: %v", region, err)
+			continue
+		}
+		allServices = append(allServices, services...)
+	}
+	
+	s.logger.Debugf("Retrieved %d ECS services", len(allServices))
+	return allServices, nil
+}
+
+// GetTasks retrieves the running tasks of all ECS clusters
+func (s *ECSService) GetTasks(ctx context.Context, filters shared.ResourceFilters) ([]models.Resource, error) {
+	var allTasks []models.Resource
+	
+	// Get regions to query
+	regions := s.getRegionsToQuery(filters.Regions)
+	
+	for _, region := range regions {
+		tasks, err := s.getTasksInRegion(ctx, region, filters)
+		if err != nil {
+			s.logger.Errorf("Failed to get ECS tasks in region %s: %v", region, err)
+			continue
+		}
+		allTasks = append(allTasks, tasks...)
+	}
+	
+	s.logger.Debugf("Retrieved %d ECS tasks", len(allTasks))
+	return allTasks, nil
+}
+
+// GetClusterStatus retrieves the status of an ECS cluster by name or ARN
+func (s *ECSService) GetClusterStatus(ctx context.Context, cluster string) (*models.ResourceStatus, error) {
+	for _, region := range s.getRegionsToQuery(lookupRegions(cluster)) {
+		regionClient := s.createRegionClient(region)
+		
+		result, err := regionClient.DescribeClusters(ctx, &ecs.DescribeClustersInput{
+			Clusters: []string{cluster},
+		})
+		if hasErrorCode(err, "ClusterNotFoundException", "InvalidParameterException") {
+			continue // Try next region
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to look up ECS cluster %s in %s: %w", cluster, region, err)
+		}
+		if len(result.Clusters) == 0 {
+			continue
+		}
+		
+		state := strings.ToLower(aws.ToString(result.Clusters[0].Status))
+		return &models.ResourceStatus{
+			State:       state,
+			Health:      s.mapClusterStatusToHealth(state),
+			LastChecked: time.Now(),
+		}, nil
+	}
+	
+	return nil, errNotFound("ECS cluster", cluster)
+}
+
+// GetServiceStatus retrieves the status of an ECS service by ARN or as
+// cluster/service
+func (s *ECSService) GetServiceStatus(ctx context.Context, id string) (*models.ResourceStatus, error) {
+	cluster, name, ok := parseECSResourceID(id)
+	if !ok {
+		return nil, fmt.Errorf("ECS service %s must be an ARN or cluster/service", id)
+	}
+	
+	for _, region := range s.getRegionsToQuery(lookupRegions(id)) {
+		regionClient := s.createRegionClient(region)
+		
+		result, err := regionClient.DescribeServices(ctx, &ecs.DescribeServicesInput{
+			Cluster:  aws.String(cluster),
+			Services: []string{name},
+		})
+		if hasErrorCode(err, "ClusterNotFoundException", "ServiceNotFoundException", "InvalidParameterException") {
+			continue // Try next region
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to look up ECS service %s in %s: %w", id, region, err)
+		}
+		if len(result.Services) == 0 {
+			continue
+		}
+		
+		service := result.Services[0]
+		state := strings.ToLower(aws.ToString(service.Status))
+		return &models.ResourceStatus{
+			State:       state,
+			Health:      s.mapServiceHealth(state, service.RunningCount, service.DesiredCount),
+			LastChecked: time.Now(),
+		}, nil
+	}
+	
+	return nil, errNotFound("ECS service", id)
+}
+
+// GetTaskStatus retrieves the status of an ECS task by ARN or as cluster/task-id
+func (s *ECSService) GetTaskStatus(ctx context.Context, id string) (*models.ResourceStatus, error) {
+	cluster, taskID, ok := parseECSResourceID(id)
+	if !ok {
+		return nil, fmt.Errorf("ECS task %s must be an ARN or cluster/task-id", id)
+	}
+	
+	for _, region := range s.getRegionsToQuery(lookupRegions(id)) {
+		regionClient := s.createRegionClient(region)
+		
+		result, err := regionClient.DescribeTasks(ctx, &ecs.DescribeTasksInput{
+			Cluster: aws.String(cluster),
+			Tasks:   []string{taskID},
+		})
+		if hasErrorCode(err, "ClusterNotFoundException", "InvalidParameterException") {
+			continue // Try next region
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to look up ECS task %s in %s: %w", id, region, err)
+		}
+		if len(result.Tasks) == 0 {
+			continue
+		}
+		
+		task := result.Tasks[0]
+		state := strings.ToLower(aws.ToString(task.LastStatus))
+		return &models.ResourceStatus{
+			State:       state,
+			Health:      s.mapTaskHealth(state, task.HealthStatus),
+			LastChecked: time.Now(),
+		}, nil
+	}
+	
+	return nil, errNotFound("ECS task", id)
+}
+
+// getClustersInRegion retrieves ECS clusters from a specific region
+func (s *ECSService) getClustersInRegion(ctx context.Context, region string, filters shared.ResourceFilters) ([]models.Resource, error) {
+	s.logger.Debugf("Getting ECS clusters in region: %s", region)
+	
+	// Create a client for this region
+	regionClient := s.createRegionClient(region)
+	
+	clusterARNs, err := s.listClusters(ctx, regionClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ECS clusters in region %s: %w", region, err)
+	}
+	
+	var clusters []models.Resource
+	for _, batch := range batchStrings(clusterARNs, ecsDescribeClustersLimit) {
+		result, err := regionClient.DescribeClusters(ctx, &ecs.DescribeClustersInput{
+			Clusters: batch,
+			Include:  []types.ClusterField{types.ClusterFieldTags},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe ECS clusters in region %s: %w", region, err)
+		}
+		
+		for _, cluster := range result.Clusters {
+			resource := s.convertClusterToResource(cluster, region)
+			
+			// Apply additional filters
+			if filters.Matches(resource) {
+				clusters = append(clusters, *resource)
+			}
+		}
+	}
+	
+	s.logger.Debugf("Found %d ECS clusters in region %s", len(clusters), region)
+	return clusters, nil
+}
+
+// getServicesInRegion retrieves the services of every ECS cluster in a specific region
+func (s *ECSService) getServicesInRegion(ctx context.Context, region string, filters shared.ResourceFilters) ([]models.Resource, error) {
+	s.logger.Debugf("Getting ECS services in region: %s", region)
+	
+	// Create a client for this region
+	regionClient := s.createRegionClient(region)
+	
+	clusterARNs, err := s.listClusters(ctx, regionClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ECS clusters in region %s: %w", region, err)
+	}
+	
+	var services []models.Resource
+	for _, clusterARN := range clusterARNs {
+		var serviceARNs []string
+		paginator := ecs.NewListServicesPaginator(regionClient, &ecs.ListServicesInput{
+			Cluster: aws.String(clusterARN),
+		})
+		for paginator.HasMorePages() {
+			page, err := paginator.NextPage(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list ECS services in cluster %s: %w", clusterARN, err)
+			}
+			serviceARNs = append(serviceARNs, page.ServiceArns...)
+		}
+		
+		for _, batch := range batchStrings(serviceARNs, ecsDescribeServicesLimit) {
+			result, err := regionClient.DescribeServices(ctx, &ecs.DescribeServicesInput{
+				Cluster:  aws.String(clusterARN),
+				Services: batch,
+				Include:  []types.ServiceField{types.ServiceFieldTags},
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to describe ECS services in cluster %s: %w", clusterARN, err)
+			}
+			
+			for _, service := range result.Services {
+				resource := s.convertServiceToResource(service, region)
+				
+				// Apply additional filters
+				if filters.Matches(resource) {
+					services = append(services, *resource)
+				}
+			}
+		}
+	}
+	
+	s.logger.Debugf("Found %d ECS services in region %s", len(services), region)
+	return services, nil
+}
+
+// getTasksInRegion retrieves the running tasks of every ECS cluster in a specific region
+func (s *ECSService) getTasksInRegion(ctx context.Context, region string, filters shared.ResourceFilters) ([]models.Resource, error) {
+	s.logger.Debugf("Getting ECS tasks in region: %s", region)
+	
+	// Create a client for this region
+	regionClient := s.createRegionClient(region)
+	
+	clusterARNs, err := s.listClusters(ctx, regionClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ECS clusters in region %s: %w", region, err)
+	}
+	
+	var tasks []models.Resource
+	for _, clusterARN := range clusterARNs {
+		// Only running tasks are listed by default
+		var taskARNs []string
+		paginator := ecs.NewListTasksPaginator(regionClient, &ecs.ListTasksInput{
+			Cluster: aws.String(clusterARN),
+		})
+		for paginator.HasMorePages() {
+			page, err := paginator.NextPage(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list ECS tasks in cluster %s: %w", clusterARN, err)
+			}
+			taskARNs = append(taskARNs, page.TaskArns...)
+		}
+		
+		for _, batch := range batchStrings(taskARNs, ecsDescribeTasksLimit) {
+			result, err := regionClient.DescribeTasks(ctx, &ecs.DescribeTasksInput{
+				Cluster: aws.String(clusterARN),
+				Tasks:   batch,
+				Include: []types.TaskField{types.TaskFieldTags},
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to describe ECS tasks in cluster %s: %w", clusterARN, err)
+			}
+			
+			for _, task := range result.Tasks {
+				resource := s.convertTaskToResource(task, region)
+				
+				// Apply additional filters
+				if filters.Matches(resource) {
+					tasks = append(tasks, *resource)
+				}
+			}
+		}
+	}
+	
+	s.logger.Debugf("Found %d ECS tasks in region %s", len(tasks), region)
+	return tasks, nil
+}
+
+// listClusters lists the ARNs of the ECS clusters in a region
+func (s *ECSService) listClusters(ctx context.Context, client *ecs.Client) ([]string, error) {
+	var clusterARNs []string
+	
+	paginator := ecs.NewListClustersPaginator(client, &ecs.ListClustersInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		clusterARNs = append(clusterARNs, page.ClusterArns...)
+	}
+	
+	return clusterARNs, nil
+}
+
+// convertClusterToResource converts an ECS cluster to a Resource model
+func (s *ECSService) convertClusterToResource(cluster types.Cluster, region string) *models.Resource {
+	name := aws.ToString(cluster.ClusterName)
+	
+	resource := models.NewResource(
+		name,
+		name,
+		string(models.ResourceTypeContainer),
+		"aws",
+		region,
+	)
+	resource.NativeType = models.NativeTypeECSCluster
+	resource.ARN = aws.ToString(cluster.ClusterArn)
+	
+	// Update status
+	state := strings.ToLower(aws.ToString(cluster.Status))
+	resource.UpdateStatus(state, s.mapClusterStatusToHealth(state))
+	
+	// Set tags
+	resource.Tags = convertECSTags(cluster.Tags)
+	
+	// Add metadata
+	resource.SetMetadata("active_services_count", cluster.ActiveServicesCount)
+	resource.SetMetadata("running_tasks_count", cluster.RunningTasksCount)
+	resource.SetMetadata("pending_tasks_count", cluster.PendingTasksCount)
+	resource.SetMetadata("registered_container_instances_count", cluster.RegisteredContainerInstancesCount)
+	resource.SetMetadata("capacity_providers", cluster.CapacityProviders)
+	
+	return resource
+}
+
+// convertServiceToResource converts an ECS service to a Resource model. Service
+// names are only unique within a cluster, so the ID includes the cluster name.
+func (s *ECSService) convertServiceToResource(service types.Service, region string) *models.Resource {
+	name := aws.ToString(service.ServiceName)
+	cluster := ecsNameFromARN(aws.ToString(service.ClusterArn))
+	
+	resource := models.NewResource(
+		cluster+"/"+name,
+		name,
+		string(models.ResourceTypeContainer),
+		"aws",
+		region,
+	)
+	resource.NativeType = models.NativeTypeECSService
+	resource.ARN = aws.ToString(service.ServiceArn)
+	
+	// Update status; health compares running and desired task counts
+	state := strings.ToLower(aws.ToString(service.Status))
+	resource.UpdateStatus(state, s.mapServiceHealth(state, service.RunningCount, service.DesiredCount))
+	
+	// Set tags
+	resource.Tags = convertECSTags(service.Tags)
+	
+	// Set creation time
+	if service.CreatedAt != nil {
+		resource.CreatedAt = *service.CreatedAt
+	}
+	
+	// Add metadata
+	taskDefinition := aws.ToString(service.TaskDefinition)
+	resource.SetMetadata("cluster", cluster)
+	resource.SetMetadata("desired_count", service.DesiredCount)
+	resource.SetMetadata("running_count", service.RunningCount)
+	resource.SetMetadata("pending_count", service.PendingCount)
+	resource.SetMetadata("launch_type", string(service.LaunchType))
+	resource.SetMetadata("scheduling_strategy", string(service.SchedulingStrategy))
+	resource.SetMetadata("task_definition", taskDefinition)
+	resource.SetMetadata("task_definition_revision", taskDefinitionRevision(taskDefinition))
+	resource.SetMetadata("platform_version", aws.ToString(service.PlatformVersion))
+	resource.SetMetadata("role", aws.ToString(service.RoleArn))
+	
+	// Rollout state of the primary deployment
+	for _, deployment := range service.Deployments {
+		if aws.ToString(deployment.Status) == "PRIMARY" {
+			resource.SetMetadata("rollout_state", string(deployment.RolloutState))
+		}
+	}
+	resource.SetMetadata("deployments", len(service.Deployments))
+	
+	// Load balancer bindings
+	var loadBalancers []map[string]interface{}
+	for _, lb := range service.LoadBalancers {
+		binding := map[string]interface{}{
+			"container_name": aws.ToString(lb.ContainerName),
+			"container_port": aws.ToInt32(lb.ContainerPort),
+		}
+		if arn := aws.ToString(lb.TargetGroupArn); arn != "" {
+			binding["target_group_arn"] = arn
+		}
+		if name := aws.ToString(lb.LoadBalancerName); name != "" {
+			binding["load_balancer_name"] = name
+		}
+		loadBalancers = append(loadBalancers, binding)
+	}
+	resource.SetMetadata("load_balancers", loadBalancers)
+	
+	// Network configuration for awsvpc tasks
+	if service.NetworkConfiguration != nil && service.NetworkConfiguration.AwsvpcConfiguration != nil {
+		vpcConfig := service.NetworkConfiguration.AwsvpcConfiguration
+		resource.SetMetadata("subnet_ids", vpcConfig.Subnets)
+		resource.SetMetadata("security_groups", vpcConfig.SecurityGroups)
+		resource.SetMetadata("assign_public_ip", string(vpcConfig.AssignPublicIp))
+	}
+	
+	return resource
+}
+
+// convertTaskToResource converts an ECS task to a Resource model
+func (s *ECSService) convertTaskToResource(task types.Task, region string) *models.Resource {
+	taskID := ecsNameFromARN(aws.ToString(task.TaskArn))
+	cluster := ecsNameFromARN(aws.ToString(task.ClusterArn))
+	
+	// Tasks are identified as cluster/task-id, like services, so the ID
+	// can be passed back to status and describe
+	resource := models.NewResource(
+		cluster+"/"+taskID,
+		taskID,
+		string(models.ResourceTypeContainer),
+		"aws",
+		region,
+	)
+	resource.NativeType = models.NativeTypeECSTask
+	resource.ARN = aws.ToString(task.TaskArn)
+	
+	// Update status
+	state := strings.ToLower(aws.ToString(task.LastStatus))
+	resource.UpdateStatus(state, s.mapTaskHealth(state, task.HealthStatus))
+	
+	// Set tags
+	resource.Tags = convertECSTags(task.Tags)
+	
+	// Set creation time
+	if task.CreatedAt != nil {
+		resource.CreatedAt = *task.CreatedAt
+	}
+	
+	// Add metadata
+	taskDefinition := aws.ToString(task.TaskDefinitionArn)
+	resource.SetMetadata("cluster", cluster)
+	resource.SetMetadata("group", aws.ToString(task.Group))
+	resource.SetMetadata("launch_type", string(task.LaunchType))
+	resource.SetMetadata("task_definition", taskDefinition)
+	resource.SetMetadata("task_definition_revision", taskDefinitionRevision(taskDefinition))
+	resource.SetMetadata("desired_status", strings.ToLower(aws.ToString(task.DesiredStatus)))
+	resource.SetMetadata("health_status", strings.ToLower(string(task.HealthStatus)))
+	resource.SetMetadata("cpu", aws.ToString(task.Cpu))
+	resource.SetMetadata("memory", aws.ToString(task.Memory))
+	resource.SetMetadata("availability_zone", aws.ToString(task.AvailabilityZone))
+	resource.SetMetadata("platform_version", aws.ToString(task.PlatformVersion))
+	if task.StartedAt != nil {
+		resource.SetMetadata("started_at", *task.StartedAt)
+	}
+	
+	// Containers
+	var containers []map[string]interface{}
+	for _, container := range task.Containers {
+		containers = append(containers, map[string]interface{}{
+			"name":          aws.ToString(container.Name),
+			"image":         aws.ToString(container.Image),
+			"last_status":   strings.ToLower(aws.ToString(container.LastStatus)),
+			"health_status": strings.ToLower(string(container.HealthStatus)),
+		})
+	}
+	resource.SetMetadata("containers", containers)
+	
+	return resource
+}
+
+// mapClusterStatusToHealth maps ECS cluster status to resource health
+func (s *ECSService) mapClusterStatusToHealth(status string) string {
+	switch status {
+	case "active":
+		return string(models.HealthHealthy)
+	case "provisioning", "deprovisioning", "inactive":
+		return string(models.HealthWarning)
+	case "failed":
+		return string(models.HealthUnhealthy)
+	default:
+		return string(models.HealthUnknown)
+	}
+}
+
+// mapServiceHealth derives ECS service health from its running and desired
+// task counts. A service scaled to zero is healthy; one with no running tasks
+// is unhealthy, and one with fewer than desired is in warning.
+func (s *ECSService) mapServiceHealth(status string, running, desired int32) string {
+	switch status {
+	case "active":
+	case "draining":
+		return string(models.HealthWarning)
+	default:
+		return string(models.HealthUnknown)
+	}
+	
+	switch {
+	case running >= desired:
+		return string(models.HealthHealthy)
+	case running == 0:
+		return string(models.HealthUnhealthy)
+	default:
+		return string(models.HealthWarning)
+	}
+}
+
+// mapTaskHealth maps ECS task status and container health checks to
+// resource health. Tasks without health checks are healthy while running.
+func (s *ECSService) mapTaskHealth(status string, health types.HealthStatus) string {
+	if health == types.HealthStatusUnhealthy {
+		return string(models.HealthUnhealthy)
+	}
+	
+	switch status {
+	case "running":
+		return string(models.HealthHealthy)
+	case "provisioning", "pending", "activating", "deactivating", "stopping", "deprovisioning":
+		return string(models.HealthWarning)
+	case "stopped":
+		return string(models.HealthUnhealthy)
+	default:
+		return string(models.HealthUnknown)
+	}
+}
+
+// getRegionsToQuery determines which regions to query based on filters and config
+func (s *ECSService) getRegionsToQuery(filterRegions []string) []string {
+	// If specific regions are requested via filters, use those
+	if len(filterRegions) > 0 {
+		return filterRegions
+	}
+	
+	// If regions are configured, use those
+	configRegions := s.config.GetRegions()
+	if len(configRegions) > 0 {
+		return configRegions
+	}
+	
+	// Fallback to primary region if no regions specified
+	if s.config.Region != "" {
+		return []string{s.config.Region}
+	}
+	
+	// Ultimate fallback to us-east-1
+	return []string{"us-east-1"}
+}
+
+// createRegionClient creates an ECS client for a specific region
+func (s *ECSService) createRegionClient(region string) *ecs.Client {
+	// Create a new config with the specific region
+	cfg := s.client.Options()
+	cfg.Region = region
+	
+	return ecs.New(cfg)
+}
+
+// ecsNameFromARN returns the last part of an ECS ARN: a cluster or service
+// name, a task ID or a task definition family and revision
+func ecsNameFromARN(arn string) string {
+	return arn[strings.LastIndex(arn, "/")+1:]
+}
+
+// taskDefinitionRevision returns the revision number of a task definition
+// ARN such as arn:aws:ecs:us-east-1:123456789012:task-definition/web:42,
+// or 0 when it has none
+func taskDefinitionRevision(arn string) int {
+	revision, err := strconv.Atoi(arn[strings.LastIndex(arn, ":")+1:])
+	if err != nil {
+		return 0
+	}
+	return revision
+}
+
+// parseECSResourceID splits a service or task given as cluster/name, or as
+// an ARN in the long format such as
+// arn:aws:ecs:us-east-1:123456789012:service/cluster/web, into its cluster
+// and name
+func parseECSResourceID(id string) (cluster, name string, ok bool) {
+	if service, _, resource, isARN := parseARN(id); isARN {
+		if service != "ecs" {
+			return "", "", false
+		}
+		_, id, _ = strings.Cut(resource, "/")
+	}
+	
+	cluster, name, ok = strings.Cut(id, "/")
+	if !ok || cluster == "" || name == "" || strings.Contains(name, "/") {
+		return "", "", false
+	}
+	return cluster, name, true
+}
+
+// convertECSTags converts ECS tags to a map
+func convertECSTags(tags []types.Tag) map[string]string {
+	converted := make(map[string]string, len(tags))
+	for _, tag := range tags {
+		converted[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+	return converted
+}
+
+// batchStrings splits items into batches of at most size items
+func batchStrings(items []string, size int) [][]string {
+	var batches [][]string
+	for len(items) > size {
+		batches = append(batches, items[:size])
+		items = items[size:]
+	}
+	if len(items) > 0 {
+		batches = append(batches, items)
+	}
+	return batches
+}
@@ -0,0 +1,166 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Tsahi-Elkayam/cloudview/pkg/config"
+	"github.com/Tsahi-Elkayam/cloudview/pkg/models"
+)
+
+func TestConvertServiceToResource(t *testing.T) {
+	service := NewECSService(nil, &config.AWSConfig{Region: "us-east-1"}, logrus.New())
+	created := time.Date(2024, 3, 1, 12, 30, 0, 0, time.UTC)
+
+	resource := service.convertServiceToResource(types.Service{
+		ServiceName:    aws.String("web"),
+		ServiceArn:     aws.String("arn:aws:ecs:us-east-1:123456789012:service/prod/web"),
+		ClusterArn:     aws.String("arn:aws:ecs:us-east-1:123456789012:cluster/prod"),
+		Status:         aws.String("ACTIVE"),
+		CreatedAt:      &created,
+		DesiredCount:   3,
+		RunningCount:   2,
+		LaunchType:     types.LaunchTypeFargate,
+		TaskDefinition: aws.String("arn:aws:ecs:us-east-1:123456789012:task-definition/web:42"),
+		LoadBalancers: []types.LoadBalancer{{
+			TargetGroupArn: aws.String("arn:aws:elasticloadbalancing:us-east-1:123456789012:targetgroup/web/73e2d6bc24d8a067"),
+			ContainerName:  aws.String("app"),
+			ContainerPort:  aws.Int32(8080),
+		}},
+		Deployments: []types.Deployment{{Status: aws.String("PRIMARY"), RolloutState: types.DeploymentRolloutStateInProgress}},
+		Tags:        []types.Tag{{Key: aws.String("team"), Value: aws.String("payments")}},
+	}, "us-east-1")
+
+	assert.Equal(t, "prod/web", resource.ID)
+	assert.Equal(t, "web", resource.Name)
+	assert.Equal(t, string(models.ResourceTypeContainer), resource.Type)
+	assert.Equal(t, models.NativeTypeECSService, resource.NativeType)
+	assert.Equal(t, "arn:aws:ecs:us-east-1:123456789012:service/prod/web", resource.ARN)
+	assert.Equal(t, "active", resource.Status.State)
+	assert.Equal(t, string(models.HealthWarning), resource.Status.Health)
+	assert.Equal(t, created, resource.CreatedAt)
+	assert.Equal(t, "payments", resource.Tags["team"])
+
+	assert.Equal(t, "prod", resource.Metadata["cluster"])
+	assert.Equal(t, int32(3), resource.Metadata["desired_count"])
+	assert.Equal(t, int32(2), resource.Metadata["running_count"])
+	assert.Equal(t, "FARGATE", resource.Metadata["launch_type"])
+	assert.Equal(t, 42, resource.Metadata["task_definition_revision"])
+	assert.Equal(t, "IN_PROGRESS", resource.Metadata["rollout_state"])
+	assert.Equal(t, []map[string]interface{}{{
+		"container_name":   "app",
+		"container_port":   int32(8080),
+		"target_group_arn": "arn:aws:elasticloadbalancing:us-east-1:123456789012:targetgroup/web/73e2d6bc24d8a067",
+	}}, resource.Metadata["load_balancers"])
+}
+
+func TestConvertTaskToResource(t *testing.T) {
+	service := NewECSService(nil, &config.AWSConfig{Region: "us-east-1"}, logrus.New())
+
+	resource := service.convertTaskToResource(types.Task{
+		TaskArn:           aws.String("arn:aws:ecs:us-east-1:123456789012:task/prod/0a1b2c3d4e5f"),
+		ClusterArn:        aws.String("arn:aws:ecs:us-east-1:123456789012:cluster/prod"),
+		TaskDefinitionArn: aws.String("arn:aws:ecs:us-east-1:123456789012:task-definition/web:42"),
+		LastStatus:        aws.String("RUNNING"),
+		HealthStatus:      types.HealthStatusUnknown,
+		Group:             aws.String("service:web"),
+		Containers:        []types.Container{{Name: aws.String("app"), Image: aws.String("web:1.4"), LastStatus: aws.String("RUNNING")}},
+	}, "us-east-1")
+
+	assert.Equal(t, "prod/0a1b2c3d4e5f", resource.ID)
+	assert.Equal(t, "0a1b2c3d4e5f", resource.Name)
+	assert.Equal(t, models.NativeTypeECSTask, resource.NativeType)
+	assert.Equal(t, "running", resource.Status.State)
+	assert.Equal(t, string(models.HealthHealthy), resource.Status.Health)
+	assert.Equal(t, "prod", resource.Metadata["cluster"])
+	assert.Equal(t, "service:web", resource.Metadata["group"])
+	assert.Equal(t, 42, resource.Metadata["task_definition_revision"])
+}
+
+func TestMapServiceHealth(t *testing.T) {
+	service := NewECSService(nil, &config.AWSConfig{}, logrus.New())
+
+	tests := []struct {
+		name    string
+		status  string
+		running int32
+		desired int32
+		want    models.ResourceHealth
+	}{
+		{"all running", "active", 3, 3, models.HealthHealthy},
+		{"scaled to zero", "active", 0, 0, models.HealthHealthy},
+		{"some running", "active", 1, 3, models.HealthWarning},
+		{"none running", "active", 0, 3, models.HealthUnhealthy},
+		{"draining", "draining", 3, 3, models.HealthWarning},
+		{"inactive", "inactive", 0, 0, models.HealthUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, string(tt.want), service.mapServiceHealth(tt.status, tt.running, tt.desired))
+		})
+	}
+}
+
+func TestMapTaskHealth(t *testing.T) {
+	service := NewECSService(nil, &config.AWSConfig{}, logrus.New())
+
+	assert.Equal(t, string(models.HealthHealthy), service.mapTaskHealth("running", types.HealthStatusHealthy))
+	assert.Equal(t, string(models.HealthUnhealthy), service.mapTaskHealth("running", types.HealthStatusUnhealthy))
+	assert.Equal(t, string(models.HealthWarning), service.mapTaskHealth("pending", types.HealthStatusUnknown))
+	assert.Equal(t, string(models.HealthUnhealthy), service.mapTaskHealth("stopped", ""))
+}
+
+func TestParseECSResourceID(t *testing.T) {
+	tests := []struct {
+		id      string
+		cluster string
+		name    string
+		ok      bool
+	}{
+		{"arn:aws:ecs:us-east-1:123456789012:service/prod/web", "prod", "web", true},
+		{"arn:aws:ecs:us-east-1:123456789012:task/prod/0a1b2c3d4e5f", "prod", "0a1b2c3d4e5f", true},
+		{"prod/web", "prod", "web", true},
+		{"arn:aws:ecs:us-east-1:123456789012:service/web", "", "", false},
+		{"arn:aws:lambda:us-east-1:123456789012:function:web", "", "", false},
+		{"web", "", "", false},
+		{"prod/", "", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			cluster, name, ok := parseECSResourceID(tt.id)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.cluster, cluster)
+			assert.Equal(t, tt.name, name)
+		})
+	}
+}
+
+func TestBatchStrings(t *testing.T) {
+	assert.Nil(t, batchStrings(nil, 10))
+	assert.Equal(t, [][]string{{"a", "b"}, {"c"}}, batchStrings([]string{"a", "b", "c"}, 2))
+}
+
+func TestECSFetcherSelection(t *testing.T) {
+	provider, err := NewAWSProvider(&config.AWSConfig{Region: "us-east-1"}, nil)
+	assert.NoError(t, err)
+
+	selected := make(map[string]bool)
+	for _, fetcher := range provider.resourceFetchers() {
+		if fetcher.selectedBy([]string{"ecs"}) {
+			selected[fetcher.nativeType] = true
+		}
+	}
+	assert.Equal(t, map[string]bool{
+		models.NativeTypeECSCluster: true,
+		models.NativeTypeECSService: true,
+		models.NativeTypeECSTask:    true,
+	}, selected)
+	assert.Contains(t, provider.GetSupportedResourceTypes(), "ecs")
+}
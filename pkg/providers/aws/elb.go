@@ -0,0 +1,416 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	elb "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Tsahi-Elkayam/cloudview/pkg/config"
+	"github.com/Tsahi-Elkayam/cloudview/pkg/models"
+	shared "github.com/Tsahi-Elkayam/cloudview/pkg/types"
+)
+
+// ELBService handles Elastic Load Balancing operations for application,
+// network and gateway load balancers
+type ELBService struct {
+	client *elb.Client
+	config *config.AWSConfig
+	logger *logrus.Logger
+}
+
+// targetGroupHealth is a target group with the health of its registered targets
+type targetGroupHealth struct {
+	group   types.TargetGroup
+	targets []types.TargetHealthDescription
+}
+
+// NewELBService creates a new ELB service
+func NewELBService(client *elb.Client, cfg *config.AWSConfig, logger *logrus.Logger) *ELBService {
+	return &ELBService{
+		client: client,
+		config: cfg,
+		logger: logger,
+	}
+}
+
+// GetLoadBalancers retrieves all load balancers with their listeners and
+// target groups
+func (s *ELBService) GetLoadBalancers(ctx context.Context, filters shared.ResourceFilters) ([]models.Resource, error) {
+	var allLoadBalancers []models.Resource
+	
+	// Get regions to query
+	regions := s.getRegionsToQuery(filters.Regions)
+	
+	for _, region := range regions {
+		loadBalancers, err := s.getLoadBalancersInRegion(ctx, region, filters)
+		if err != nil {
+			s.logger.Errorf("Failed to get load balancers in region %s: %v", region, err)
+			continue
+		}
+		allLoadBalancers = append(allLoadBalancers, loadBalancers...)
+	}
+	
+	s.logger.Debugf("Retrieved %d load balancers", len(allLoadBalancers))
+	return allLoadBalancers, nil
+}
+
+// GetLoadBalancerStatus retrieves the status of a load balancer by name or
+// ARN. Health reflects the health of its registered targets.
+func (s *ELBService) GetLoadBalancerStatus(ctx context.Context, nameOrARN string) (*models.ResourceStatus, error) {
+	input := &elb.DescribeLoadBalancersInput{Names: []string{nameOrARN}}
+	if _, _, _, ok := parseARN(nameOrARN); ok {
+		input = &elb.DescribeLoadBalancersInput{LoadBalancerArns: []string{nameOrARN}}
+	}
+	
+	for _, region := range s.getRegionsToQuery(lookupRegions(nameOrARN)) {
+		regionClient := s.createRegionClient(region)
+		
+		result, err := regionClient.DescribeLoadBalancers(ctx, input)
+		if hasErrorCode(err, "LoadBalancerNotFound", "ValidationError") {
+			continue // Try next region
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to look up load balancer %s in %s: %w", nameOrARN, region, err)
+		}
+		if len(result.LoadBalancers) == 0 {
+			continue
+		}
+		
+		lb := result.LoadBalancers[0]
+		groups, err := s.getTargetGroupHealth(ctx, regionClient, aws.ToString(lb.LoadBalancerArn))
+		if err != nil {
+			return nil, err
+		}
+		
+		state := s.loadBalancerState(lb)
+		return &models.ResourceStatus{
+			State:       state,
+			Health:      s.mapLoadBalancerHealth(state, groups),
+			LastChecked: time.Now(),
+		}, nil
+	}
+	
+	return nil, errNotFound("load balancer", nameOrARN)
+}
+
+// getLoadBalancersInRegion retrieves load balancers from a specific region
+func (s *ELBService) getLoadBalancersInRegion(ctx context.Context, region string, filters shared.ResourceFilters) ([]models.Resource, error) {
+	s.logger.Debugf("Getting load balancers in region: %s", region)
+	
+	// Create a client for this region
+	regionClient := s.createRegionClient(region)
+	
+	var loadBalancers []models.Resource
+	
+	// Use paginator to handle large result sets
+	paginator := elb.NewDescribeLoadBalancersPaginator(regionClient, &elb.DescribeLoadBalancersInput{})
+	
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe load balancers in region %s: %w", region, err)
+		}
+		
+		for _, lb := range page.LoadBalancers {
+			arn := aws.ToString(lb.LoadBalancerArn)
+			
+			listeners, err := s.getListeners(ctx, regionClient, arn)
+			if err != nil {
+				s.logger.Debugf("Failed to get listeners for load balancer %s: %v", arn, err)
+			}
+			
+			groups, groupsErr := s.getTargetGroupHealth(ctx, regionClient, arn)
+			
+			resource := s.convertLoadBalancerToResource(lb, listeners, groups, region)
+			
+			// Without its targets the load balancer's health isn't known
+			if groupsErr != nil {
+				s.logger.Debugf("Failed to get target groups for load balancer %s: %v", arn, groupsErr)
+				resource.Status.Health = string(models.HealthUnknown)
+			}
+			
+			// Get load balancer tags
+			tags, err := s.getLoadBalancerTags(ctx, regionClient, arn)
+			if err != nil {
+				s.logger.Debugf("Failed to get tags for load balancer %s: %v", arn, err)
+			} else {
+				resource.Tags = tags
+			}
+			
+			// Apply additional filters
+			if filters.Matches(resource) {
+				loadBalancers = append(loadBalancers, *resource)
+			}
+		}
+	}
+	
+	s.logger.Debugf("Found %d load balancers in region %s", len(loadBalancers), region)
+	return loadBalancers, nil
+}
+
+// getListeners gets the listeners of a load balancer
+func (s *ELBService) getListeners(ctx context.Context, client *elb.Client, lbARN string) ([]types.Listener, error) {
+	var listeners []types.Listener
+	
+	paginator := elb.NewDescribeListenersPaginator(client, &elb.DescribeListenersInput{
+		LoadBalancerArn: aws.String(lbARN),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		listeners = append(listeners, page.Listeners...)
+	}
+	
+	return listeners, nil
+}
+
+// getTargetGroupHealth gets the target groups of a load balancer and the
+// health of their targets
+func (s *ELBService) getTargetGroupHealth(ctx context.Context, client *elb.Client, lbARN string) ([]targetGroupHealth, error) {
+	var groups []targetGroupHealth
+	
+	paginator := elb.NewDescribeTargetGroupsPaginator(client, &elb.DescribeTargetGroupsInput{
+		LoadBalancerArn: aws.String(lbARN),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		
+		for _, group := range page.TargetGroups {
+			health, err := client.DescribeTargetHealth(ctx, &elb.DescribeTargetHealthInput{
+				TargetGroupArn: group.TargetGroupArn,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to describe target health of %s: %w", aws.ToString(group.TargetGroupName), err)
+			}
+			groups = append(groups, targetGroupHealth{group: group, targets: health.TargetHealthDescriptions})
+		}
+	}
+	
+	return groups, nil
+}
+
+// getLoadBalancerTags gets the tags of a load balancer
+func (s *ELBService) getLoadBalancerTags(ctx context.Context, client *elb.Client, lbARN string) (map[string]string, error) {
+	result, err := client.DescribeTags(ctx, &elb.DescribeTagsInput{
+		ResourceArns: []string{lbARN},
+	})
+	if err != nil {
+		return nil, err
+	}
+	
+	tags := make(map[string]string)
+	for _, description := range result.TagDescriptions {
+		for _, tag := range description.Tags {
+			tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+		}
+	}
+	return tags, nil
+}
+
+// convertLoadBalancerToResource converts a load balancer to a Resource model
+func (s *ELBService) convertLoadBalancerToResource(lb types.LoadBalancer, listeners []types.Listener, groups []targetGroupHealth, region string) *models.Resource {
+	name := aws.ToString(lb.LoadBalancerName)
+	
+	resource := models.NewResource(
+		name,
+		name,
+		string(models.ResourceTypeLoadBalancer),
+		"aws",
+		region,
+	)
+	resource.NativeType = models.NativeTypeELBv2LoadBalancer
+	resource.ARN = aws.ToString(lb.LoadBalancerArn)
+	
+	// Update status; health comes from the registered targets
+	state := s.loadBalancerState(lb)
+	resource.UpdateStatus(state, s.mapLoadBalancerHealth(state, groups))
+	
+	// Set creation time
+	if lb.CreatedTime != nil {
+		resource.CreatedAt = *lb.CreatedTime
+	}
+	
+	// Add metadata
+	resource.SetMetadata("load_balancer_type", string(lb.Type))
+	resource.SetMetadata("scheme", string(lb.Scheme))
+	resource.SetMetadata("dns_name", aws.ToString(lb.DNSName))
+	resource.SetMetadata("ip_address_type", string(lb.IpAddressType))
+	resource.SetMetadata("vpc_id", aws.ToString(lb.VpcId))
+	resource.SetMetadata("security_groups", lb.SecurityGroups)
+	
+	var zones, subnets []string
+	for _, zone := range lb.AvailabilityZones {
+		zones = append(zones, aws.ToString(zone.ZoneName))
+		subnets = append(subnets, aws.ToString(zone.SubnetId))
+	}
+	resource.SetMetadata("availability_zones", zones)
+	resource.SetMetadata("subnet_ids", subnets)
+	
+	// Listeners
+	var listenerInfo []map[string]interface{}
+	var ports []int32
+	var sslPolicies []string
+	for _, listener := range listeners {
+		info := map[string]interface{}{
+			"protocol": string(listener.Protocol),
+			"port":     aws.ToInt32(listener.Port),
+		}
+		if policy := aws.ToString(listener.SslPolicy); policy != "" {
+			info["ssl_policy"] = policy
+			sslPolicies = append(sslPolicies, policy)
+		}
+		var certificates []string
+		for _, certificate := range listener.Certificates {
+			certificates = append(certificates, aws.ToString(certificate.CertificateArn))
+		}
+		if len(certificates) > 0 {
+			info["certificates"] = certificates
+		}
+		
+		listenerInfo = append(listenerInfo, info)
+		ports = append(ports, aws.ToInt32(listener.Port))
+	}
+	resource.SetMetadata("listeners", listenerInfo)
+	resource.SetMetadata("listener_ports", ports)
+	resource.SetMetadata("ssl_policies", sslPolicies)
+	
+	// Target groups and per-target health
+	var groupInfo []map[string]interface{}
+	var healthy, total int
+	for _, group := range groups {
+		var targets []string
+		groupHealthy := 0
+		for _, target := range group.targets {
+			targets = append(targets, fmt.Sprintf("%s %s", targetAddress(target), targetState(target)))
+			if targetState(target) == types.TargetHealthStateEnumHealthy {
+				groupHealthy++
+			}
+		}
+		groupInfo = append(groupInfo, map[string]interface{}{
+			"name":            aws.ToString(group.group.TargetGroupName),
+			"arn":             aws.ToString(group.group.TargetGroupArn),
+			"protocol":        string(group.group.Protocol),
+			"port":            aws.ToInt32(group.group.Port),
+			"target_type":     string(group.group.TargetType),
+			"targets":         targets,
+			"healthy_targets": groupHealthy,
+		})
+		healthy += groupHealthy
+		total += len(group.targets)
+	}
+	resource.SetMetadata("target_groups", groupInfo)
+	resource.SetMetadata("healthy_targets", healthy)
+	resource.SetMetadata("total_targets", total)
+	
+	return resource
+}
+
+// loadBalancerState returns a load balancer's state
+func (s *ELBService) loadBalancerState(lb types.LoadBalancer) string {
+	if lb.State == nil {
+		return string(models.StateUnknown)
+	}
+	return string(lb.State.Code)
+}
+
+// mapLoadBalancerHealth maps a load balancer's state and the health of its
+// targets to resource health. An active load balancer is unhealthy when its
+// target groups have no healthy targets, and in warning when only some
+// targets are healthy.
+func (s *ELBService) mapLoadBalancerHealth(state string, groups []targetGroupHealth) string {
+	switch types.LoadBalancerStateEnum(state) {
+	case types.LoadBalancerStateEnumActive:
+	case types.LoadBalancerStateEnumProvisioning, types.LoadBalancerStateEnumActiveImpaired:
+		return string(models.HealthWarning)
+	case types.LoadBalancerStateEnumFailed:
+		return string(models.HealthUnhealthy)
+	default:
+		return string(models.HealthUnknown)
+	}
+	
+	// Load balancers that only answer with fixed responses or redirects
+	// have no target groups
+	if len(groups) == 0 {
+		return string(models.HealthHealthy)
+	}
+	
+	var healthy, total int
+	for _, group := range groups {
+		for _, target := range group.targets {
+			total++
+			if targetState(target) == types.TargetHealthStateEnumHealthy {
+				healthy++
+			}
+		}
+	}
+	
+	switch {
+	case healthy == 0:
+		return string(models.HealthUnhealthy)
+	case healthy < total:
+		return string(models.HealthWarning)
+	default:
+		return string(models.HealthHealthy)
+	}
+}
+
+// targetAddress returns a target's ID, an instance ID, IP address or Lambda
+// ARN, with its port if it has one
+func targetAddress(target types.TargetHealthDescription) string {
+	if target.Target == nil {
+		return ""
+	}
+	if target.Target.Port == nil {
+		return aws.ToString(target.Target.Id)
+	}
+	return fmt.Sprintf("%s:%d", aws.ToString(target.Target.Id), aws.ToInt32(target.Target.Port))
+}
+
+// targetState returns the health state of a target
+func targetState(target types.TargetHealthDescription) types.TargetHealthStateEnum {
+	if target.TargetHealth == nil {
+		return ""
+	}
+	return target.TargetHealth.State
+}
+
+// getRegionsToQuery determines which regions to query based on filters and config
+func (s *ELBService) getRegionsToQuery(filterRegions []string) []string {
+	// If specific regions are requested via filters, use those
+	if len(filterRegions) > 0 {
+		return filterRegions
+	}
+	
+	// If regions are configured, use those
+	configRegions := s.config.GetRegions()
+	if len(configRegions) > 0 {
+		return configRegions
+	}
+	
+	// Fallback to primary region if no regions specified
+	if s.config.Region != "" {
+		return []string{s.config.Region}
+	}
+	
+	// Ultimate fallback to us-east-1
+	return []string{"us-east-1"}
+}
+
+// createRegionClient creates an ELB client for a specific region
+func (s *ELBService) createRegionClient(region string) *elb.Client {
+	// Create a new config with the specific region
+	cfg := s.client.Options()
+	cfg.Region = region
+	
+	return elb.New(cfg)
+}
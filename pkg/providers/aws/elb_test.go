@@ -0,0 +1,92 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Tsahi-Elkayam/cloudview/pkg/config"
+	"github.com/Tsahi-Elkayam/cloudview/pkg/models"
+)
+
+// targetGroup builds a target group whose targets are in the given states
+func targetGroup(name string, states ...types.TargetHealthStateEnum) targetGroupHealth {
+	group := targetGroupHealth{group: types.TargetGroup{
+		TargetGroupName: aws.String(name),
+		Protocol:        types.ProtocolEnumHttp,
+		Port:            aws.Int32(8080),
+	}}
+	for i, state := range states {
+		group.targets = append(group.targets, types.TargetHealthDescription{
+			Target:       &types.TargetDescription{Id: aws.String(name + "-" + string(rune('a'+i))), Port: aws.Int32(8080)},
+			TargetHealth: &types.TargetHealth{State: state},
+		})
+	}
+	return group
+}
+
+func TestMapLoadBalancerHealth(t *testing.T) {
+	service := NewELBService(nil, &config.AWSConfig{}, logrus.New())
+	healthy, unhealthy := types.TargetHealthStateEnumHealthy, types.TargetHealthStateEnumUnhealthy
+
+	tests := []struct {
+		name   string
+		state  types.LoadBalancerStateEnum
+		groups []targetGroupHealth
+		want   models.ResourceHealth
+	}{
+		{"all targets healthy", types.LoadBalancerStateEnumActive, []targetGroupHealth{targetGroup("web", healthy, healthy)}, models.HealthHealthy},
+		{"some targets unhealthy", types.LoadBalancerStateEnumActive, []targetGroupHealth{targetGroup("web", healthy), targetGroup("api", unhealthy)}, models.HealthWarning},
+		{"no healthy targets", types.LoadBalancerStateEnumActive, []targetGroupHealth{targetGroup("web", unhealthy, types.TargetHealthStateEnumDraining)}, models.HealthUnhealthy},
+		{"no registered targets", types.LoadBalancerStateEnumActive, []targetGroupHealth{targetGroup("web")}, models.HealthUnhealthy},
+		{"no target groups", types.LoadBalancerStateEnumActive, nil, models.HealthHealthy},
+		{"provisioning", types.LoadBalancerStateEnumProvisioning, nil, models.HealthWarning},
+		{"failed", types.LoadBalancerStateEnumFailed, []targetGroupHealth{targetGroup("web", healthy)}, models.HealthUnhealthy},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, string(tt.want), service.mapLoadBalancerHealth(string(tt.state), tt.groups))
+		})
+	}
+}
+
+func TestConvertLoadBalancerToResource(t *testing.T) {
+	service := NewELBService(nil, &config.AWSConfig{}, logrus.New())
+
+	lb := types.LoadBalancer{
+		LoadBalancerName: aws.String("web"),
+		LoadBalancerArn:  aws.String("arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/web/50dc6c495c0c9188"),
+		DNSName:          aws.String("web-1234.us-east-1.elb.amazonaws.com"),
+		Scheme:           types.LoadBalancerSchemeEnumInternetFacing,
+		Type:             types.LoadBalancerTypeEnumApplication,
+		State:            &types.LoadBalancerState{Code: types.LoadBalancerStateEnumActive},
+		VpcId:            aws.String("vpc-1"),
+		SecurityGroups:   []string{"sg-1"},
+	}
+	listeners := []types.Listener{
+		{Protocol: types.ProtocolEnumHttps, Port: aws.Int32(443), SslPolicy: aws.String("ELBSecurityPolicy-TLS13-1-2-2021-06")},
+		{Protocol: types.ProtocolEnumHttp, Port: aws.Int32(80)},
+	}
+	groups := []targetGroupHealth{targetGroup("web", types.TargetHealthStateEnumHealthy, types.TargetHealthStateEnumUnhealthy)}
+
+	resource := service.convertLoadBalancerToResource(lb, listeners, groups, "us-east-1")
+
+	assert.Equal(t, "web", resource.ID)
+	assert.Equal(t, string(models.ResourceTypeLoadBalancer), resource.Type)
+	assert.Equal(t, models.NativeTypeELBv2LoadBalancer, resource.NativeType)
+	assert.Equal(t, "active", resource.Status.State)
+	assert.Equal(t, string(models.HealthWarning), resource.Status.Health)
+	assert.Equal(t, "internet-facing", resource.Metadata["scheme"])
+	assert.Equal(t, "application", resource.Metadata["load_balancer_type"])
+	assert.Equal(t, []int32{443, 80}, resource.Metadata["listener_ports"])
+	assert.Equal(t, []string{"ELBSecurityPolicy-TLS13-1-2-2021-06"}, resource.Metadata["ssl_policies"])
+	assert.Equal(t, 1, resource.Metadata["healthy_targets"])
+	assert.Equal(t, 2, resource.Metadata["total_targets"])
+
+	targetGroups := resource.Metadata["target_groups"].([]map[string]interface{})
+	assert.Equal(t, []string{"web-a:8080 healthy", "web-b:8080 unhealthy"}, targetGroups[0]["targets"])
+}
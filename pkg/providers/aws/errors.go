@@ -0,0 +1,101 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go"
+
+	shared "github.com/Tsahi-Elkayam/cloudview/pkg/types"
+)
+
+// notFoundError reports that no resource matches a status lookup. It wraps
+// shared.ErrResourceNotFound so callers can tell it apart from API failures
+// such as access denied or throttling.
+type notFoundError struct {
+	description string
+	id          string
+}
+
+// Error implements the error interface
+func (e *notFoundError) Error() string {
+	return fmt.Sprintf("%s %s not found", e.description, e.id)
+}
+
+// Unwrap returns shared.ErrResourceNotFound
+func (e *notFoundError) Unwrap() error {
+	return shared.ErrResourceNotFound
+}
+
+// errNotFound returns the error for a lookup that found no resource
+func errNotFound(description, id string) error {
+	return &notFoundError{description: description, id: id}
+}
+
+// hasErrorCode reports whether err is an AWS API error with one of codes
+func hasErrorCode(err error, codes ...string) bool {
+	var apiErr smithy.APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+
+	for _, code := range codes {
+		if apiErr.ErrorCode() == code {
+			return true
+		}
+	}
+	return false
+}
+
+// lookupRegions returns the regions to search for a resource: the region of
+// a regional ARN, or nil to search every configured region
+func lookupRegions(resourceID string) []string {
+	if _, region, _, ok := parseARN(resourceID); ok && region != "" {
+		return []string{region}
+	}
+	return nil
+}
+
+// arnResourceName returns the name from the "kind/name" resource part of an
+// ARN, or id unchanged when it isn't an ARN
+func arnResourceName(id string) string {
+	if _, _, resource, ok := parseARN(id); ok {
+		if _, name, found := strings.Cut(resource, "/"); found {
+			return name
+		}
+	}
+	return id
+}
+
+// retryableError is a failed lookup that may succeed later, such as a
+// throttled call. It wraps both the failure and the shared sentinel that
+// says why it can be retried.
+type retryableError struct {
+	err    error
+	reason error
+}
+
+// Error implements the error interface
+func (e *retryableError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the failure and the reason it can be retried
+func (e *retryableError) Unwrap() []error {
+	return []error{e.err, e.reason}
+}
+
+// classifyLookupError marks throttling and other transient failures that
+// outlasted the SDK's own retries, so callers waiting on a resource can keep
+// polling instead of giving up
+func classifyLookupError(err error) error {
+	switch {
+	case retry.IsErrorThrottles(retry.DefaultThrottles).IsErrorThrottle(err).Bool():
+		return &retryableError{err: err, reason: shared.ErrRateLimitExceeded}
+	case retry.IsErrorRetryables(retry.DefaultRetryables).IsErrorRetryable(err).Bool():
+		return &retryableError{err: err, reason: shared.ErrServiceUnavailable}
+	}
+	return err
+}
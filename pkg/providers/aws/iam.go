@@ -3,7 +3,6 @@ package aws
 import (
 	"context"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -74,7 +73,7 @@ func (s *IAMService) GetUsers(ctx context.Context, filters shared.ResourceFilter
 				resource.SetMetadata("access_keys", accessKeys)
 			}
 			
-			if s.matchesFilters(resource, filters) {
+			if filters.Matches(resource) {
 				allUsers = append(allUsers, *resource)
 			}
 		}
@@ -110,7 +109,7 @@ func (s *IAMService) GetRoles(ctx context.Context, filters shared.ResourceFilter
 				resource.SetMetadata("attached_policies", policies)
 			}
 			
-			if s.matchesFilters(resource, filters) {
+			if filters.Matches(resource) {
 				allRoles = append(allRoles, *resource)
 			}
 		}
@@ -140,7 +139,7 @@ func (s *IAMService) GetPolicies(ctx context.Context, filters shared.ResourceFil
 		for _, policy := range page.Policies {
 			resource := s.convertPolicyToResource(policy)
 			
-			if s.matchesFilters(resource, filters) {
+			if filters.Matches(resource) {
 				allPolicies = append(allPolicies, *resource)
 			}
 		}
@@ -150,15 +149,62 @@ func (s *IAMService) GetPolicies(ctx context.Context, filters shared.ResourceFil
 	return allPolicies, nil
 }
 
+// GetUserStatus retrieves the status of an IAM user
+func (s *IAMService) GetUserStatus(ctx context.Context, userName string) (*models.ResourceStatus, error) {
+	if _, err := s.client.GetUser(ctx, &iam.GetUserInput{UserName: aws.String(userName)}); err != nil {
+		if hasErrorCode(err, "NoSuchEntity", "ValidationError") {
+			return nil, errNotFound("IAM user", userName)
+		}
+		return nil, fmt.Errorf("failed to look up IAM user %s: %w", userName, err)
+	}
+	
+	return activeStatus(), nil
+}
+
+// GetRoleStatus retrieves the status of an IAM role
+func (s *IAMService) GetRoleStatus(ctx context.Context, roleName string) (*models.ResourceStatus, error) {
+	if _, err := s.client.GetRole(ctx, &iam.GetRoleInput{RoleName: aws.String(roleName)}); err != nil {
+		if hasErrorCode(err, "NoSuchEntity", "ValidationError") {
+			return nil, errNotFound("IAM role", roleName)
+		}
+		return nil, fmt.Errorf("failed to look up IAM role %s: %w", roleName, err)
+	}
+	
+	return activeStatus(), nil
+}
+
+// GetPolicyStatus retrieves the status of a managed IAM policy by ARN
+func (s *IAMService) GetPolicyStatus(ctx context.Context, policyARN string) (*models.ResourceStatus, error) {
+	if _, err := s.client.GetPolicy(ctx, &iam.GetPolicyInput{PolicyArn: aws.String(policyARN)}); err != nil {
+		if hasErrorCode(err, "NoSuchEntity", "InvalidInput") {
+			return nil, errNotFound("IAM policy", policyARN)
+		}
+		return nil, fmt.Errorf("failed to look up IAM policy %s: %w", policyARN, err)
+	}
+	
+	return activeStatus(), nil
+}
+
+// activeStatus is the status of IAM entities, which exist or don't
+func activeStatus() *models.ResourceStatus {
+	return &models.ResourceStatus{
+		State:       "active",
+		Health:      string(models.HealthHealthy),
+		LastChecked: time.Now(),
+	}
+}
+
 // convertUserToResource converts an IAM user to a Resource model
 func (s *IAMService) convertUserToResource(user types.User) *models.Resource {
 	resource := models.NewResource(
 		aws.ToString(user.UserName),
 		aws.ToString(user.UserName),
-		"iam_user",
+		string(models.ResourceTypeUser),
 		"aws",
 		"global", // IAM is global
 	)
+	resource.NativeType = models.NativeTypeIAMUser
+	resource.ARN = aws.ToString(user.Arn)
 	
 	resource.UpdateStatus("active", string(models.HealthHealthy))
 	
@@ -190,10 +236,12 @@ func (s *IAMService) convertRoleToResource(role types.Role) *models.Resource {
 	resource := models.NewResource(
 		aws.ToString(role.RoleName),
 		aws.ToString(role.RoleName),
-		"iam_role", 
+		string(models.ResourceTypeRole),
 		"aws",
 		"global",
 	)
+	resource.NativeType = models.NativeTypeIAMRole
+	resource.ARN = aws.ToString(role.Arn)
 	
 	resource.UpdateStatus("active", string(models.HealthHealthy))
 	
@@ -226,10 +274,12 @@ func (s *IAMService) convertPolicyToResource(policy types.Policy) *models.Resour
 	resource := models.NewResource(
 		aws.ToString(policy.PolicyName),
 		aws.ToString(policy.PolicyName),
-		"iam_policy",
+		string(models.ResourceTypePolicy),
 		"aws", 
 		"global",
 	)
+	resource.NativeType = models.NativeTypeIAMPolicy
+	resource.ARN = aws.ToString(policy.Arn)
 	
 	resource.UpdateStatus("active", string(models.HealthHealthy))
 	
@@ -361,44 +411,3 @@ func (s *IAMService) getRolePolicies(ctx context.Context, roleName string) ([]st
 	
 	return policies, nil
 }
-
-// matchesFilters checks if a resource matches the given filters
-func (s *IAMService) matchesFilters(resource *models.Resource, filters shared.ResourceFilters) bool {
-	// Check resource type filter
-	if len(filters.ResourceTypes) > 0 {
-		found := false
-		for _, rt := range filters.ResourceTypes {
-			if strings.EqualFold(rt, "iam") || 
-			   strings.EqualFold(rt, "iam_user") || 
-			   strings.EqualFold(rt, "iam_role") || 
-			   strings.EqualFold(rt, "iam_policy") ||
-			   strings.EqualFold(rt, "user") ||
-			   strings.EqualFold(rt, "role") ||
-			   strings.EqualFold(rt, "policy") {
-				found = true
-				break
-			}
-		}
-		if !found {
-			return false
-		}
-	}
-	
-	// Check tag filters
-	for key, value := range filters.Tags {
-		if resourceValue, exists := resource.GetTag(key); !exists || resourceValue != value {
-			return false
-		}
-	}
-	
-	// Check creation time filters
-	if filters.CreatedAfter != nil && resource.CreatedAt.Before(*filters.CreatedAfter) {
-		return false
-	}
-	
-	if filters.CreatedBefore != nil && resource.CreatedAt.After(*filters.CreatedBefore) {
-		return false
-	}
-	
-	return true
-}
\ No newline at end of file
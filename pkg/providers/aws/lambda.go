@@ -0,0 +1,258 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Tsahi-Elkayam/cloudview/pkg/config"
+	"github.com/Tsahi-Elkayam/cloudview/pkg/models"
+	shared "github.com/Tsahi-Elkayam/cloudview/pkg/types"
+)
+
+// lambdaTimeLayout is the format of Lambda's LastModified timestamps
+const lambdaTimeLayout = "2006-01-02T15:04:05.000-0700"
+
+// LambdaService handles Lambda-related operations
+type LambdaService struct {
+	client *lambda.Client
+	config *config.AWSConfig
+	logger *logrus.Logger
+}
+
+// NewLambdaService creates a new Lambda service
+func NewLambdaService(client *lambda.Client, cfg *config.AWSConfig, logger *logrus.Logger) *LambdaService {
+	return &LambdaService{
+		client: client,
+		config: cfg,
+		logger: logger,
+	}
+}
+
+// GetFunctions retrieves all Lambda functions
+func (s *LambdaService) GetFunctions(ctx context.Context, filters shared.ResourceFilters) ([]models.Resource, error) {
+	var allFunctions []models.Resource
+	
+	// Get regions to query
+	regions := s.getRegionsToQuery(filters.Regions)
+	
+	for _, region := range regions {
+		functions, err := s.getFunctionsInRegion(ctx, region, filters)
+		if err != nil {
+			s.logger.Errorf("Failed to get Lambda functions in region %s: %v", region, err)
+			continue
+		}
+		allFunctions = append(allFunctions, functions...)
+	}
+	
+	s.logger.Debugf("Retrieved %d Lambda functions", len(allFunctions))
+	return allFunctions, nil
+}
+
+// GetFunctionStatus retrieves the status of a Lambda function by name or ARN
+func (s *LambdaService) GetFunctionStatus(ctx context.Context, name string) (*models.ResourceStatus, error) {
+	for _, region := range s.getRegionsToQuery(lookupRegions(name)) {
+		regionClient := s.createRegionClient(region)
+		
+		result, err := regionClient.GetFunction(ctx, &lambda.GetFunctionInput{
+			FunctionName: aws.String(name),
+		})
+		if hasErrorCode(err, "ResourceNotFoundException", "ValidationException", "InvalidParameterValueException") {
+			continue // Try next region
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to look up Lambda function %s in %s: %w", name, region, err)
+		}
+		if result.Configuration == nil {
+			continue
+		}
+		
+		state := s.functionState(result.Configuration.State)
+		return &models.ResourceStatus{
+			State:       state,
+			Health:      s.mapFunctionStateToHealth(state),
+			LastChecked: time.Now(),
+		}, nil
+	}
+	
+	return nil, errNotFound("Lambda function", name)
+}
+
+// getFunctionsInRegion retrieves Lambda functions from a specific region
+func (s *LambdaService) getFunctionsInRegion(ctx context.Context, region string, filters shared.ResourceFilters) ([]models.Resource, error) {
+	s.logger.Debugf("Getting Lambda functions in region: %s", region)
+	
+	// Create a client for this region
+	regionClient := s.createRegionClient(region)
+	
+	var functions []models.Resource
+	
+	// Use paginator to handle large result sets
+	paginator := lambda.NewListFunctionsPaginator(regionClient, &lambda.ListFunctionsInput{})
+	
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list Lambda functions in region %s: %w", region, err)
+		}
+		
+		for _, function := range page.Functions {
+			resource := s.convertFunctionToResource(function, region)
+			
+			// Tags aren't part of the function listing
+			tags, err := s.getFunctionTags(ctx, regionClient, aws.ToString(function.FunctionArn))
+			if err != nil {
+				s.logger.Debugf("Failed to get tags for Lambda function %s: %v", resource.ID, err)
+			} else {
+				resource.Tags = tags
+			}
+			
+			// Apply additional filters
+			if filters.Matches(resource) {
+				functions = append(functions, *resource)
+			}
+		}
+	}
+	
+	s.logger.Debugf("Found %d Lambda functions in region %s", len(functions), region)
+	return functions, nil
+}
+
+// getFunctionTags gets the tags of a Lambda function
+func (s *LambdaService) getFunctionTags(ctx context.Context, client *lambda.Client, functionARN string) (map[string]string, error) {
+	result, err := client.ListTags(ctx, &lambda.ListTagsInput{
+		Resource: aws.String(functionARN),
+	})
+	if err != nil {
+		return nil, err
+	}
+	
+	tags := make(map[string]string, len(result.Tags))
+	for key, value := range result.Tags {
+		tags[key] = value
+	}
+	return tags, nil
+}
+
+// convertFunctionToResource converts a Lambda function to a Resource model
+func (s *LambdaService) convertFunctionToResource(function types.FunctionConfiguration, region string) *models.Resource {
+	name := aws.ToString(function.FunctionName)
+	
+	resource := models.NewResource(
+		name,
+		name,
+		string(models.ResourceTypeFunction),
+		"aws",
+		region,
+	)
+	resource.NativeType = models.NativeTypeLambdaFunction
+	resource.ARN = aws.ToString(function.FunctionArn)
+	
+	// Update status
+	state := s.functionState(function.State)
+	resource.UpdateStatus(state, s.mapFunctionStateToHealth(state))
+	
+	// Lambda doesn't report when a function was created, only when it was
+	// last modified. CreatedAt is left zero, which filters and sorting treat
+	// as unknown, rather than keeping the discovery time.
+	resource.CreatedAt = time.Time{}
+	lastModified := aws.ToString(function.LastModified)
+	if modified, err := time.Parse(lambdaTimeLayout, lastModified); err == nil {
+		resource.UpdatedAt = modified
+	}
+	
+	// Add metadata
+	resource.SetMetadata("runtime", string(function.Runtime))
+	resource.SetMetadata("handler", aws.ToString(function.Handler))
+	resource.SetMetadata("package_type", string(function.PackageType))
+	resource.SetMetadata("description", aws.ToString(function.Description))
+	resource.SetMetadata("memory_size", aws.ToInt32(function.MemorySize))
+	resource.SetMetadata("timeout", aws.ToInt32(function.Timeout))
+	resource.SetMetadata("code_size", function.CodeSize)
+	resource.SetMetadata("last_modified", lastModified)
+	resource.SetMetadata("role", aws.ToString(function.Role))
+	resource.SetMetadata("kms_key_arn", aws.ToString(function.KMSKeyArn))
+	
+	var architectures []string
+	for _, architecture := range function.Architectures {
+		architectures = append(architectures, string(architecture))
+	}
+	resource.SetMetadata("architectures", architectures)
+	
+	// VPC configuration, only present for functions attached to a VPC
+	if function.VpcConfig != nil && aws.ToString(function.VpcConfig.VpcId) != "" {
+		resource.SetMetadata("vpc_id", aws.ToString(function.VpcConfig.VpcId))
+		resource.SetMetadata("subnet_ids", function.VpcConfig.SubnetIds)
+		resource.SetMetadata("security_groups", function.VpcConfig.SecurityGroupIds)
+	}
+	
+	// Layers
+	var layers []string
+	for _, layer := range function.Layers {
+		layers = append(layers, aws.ToString(layer.Arn))
+	}
+	resource.SetMetadata("layers", layers)
+	
+	return resource
+}
+
+// functionState returns a function's lowercase state. Function listings
+// usually leave the state out, and a listed function is active unless it
+// says otherwise.
+func (s *LambdaService) functionState(state types.State) string {
+	if state == "" {
+		return strings.ToLower(string(types.StateActive))
+	}
+	return strings.ToLower(string(state))
+}
+
+// mapFunctionStateToHealth maps Lambda function state to resource health
+func (s *LambdaService) mapFunctionStateToHealth(state string) string {
+	switch state {
+	case "active":
+		return string(models.HealthHealthy)
+	case "pending", "inactive":
+		return string(models.HealthWarning)
+	case "failed":
+		return string(models.HealthUnhealthy)
+	default:
+		return string(models.HealthUnknown)
+	}
+}
+
+// getRegionsToQuery determines which regions to query based on filters and config
+func (s *LambdaService) getRegionsToQuery(filterRegions []string) []string {
+	// If specific regions are requested via filters, use those
+	if len(filterRegions) > 0 {
+		return filterRegions
+	}
+	
+	// If regions are configured, use those
+	configRegions := s.config.GetRegions()
+	if len(configRegions) > 0 {
+		return configRegions
+	}
+	
+	// Fallback to primary region if no regions specified
+	if s.config.Region != "" {
+		return []string{s.config.Region}
+	}
+	
+	// Ultimate fallback to us-east-1
+	return []string{"us-east-1"}
+}
+
+// createRegionClient creates a Lambda client for a specific region
+func (s *LambdaService) createRegionClient(region string) *lambda.Client {
+	// Create a new config with the specific region
+	cfg := s.client.Options()
+	cfg.Region = region
+	
+	return lambda.New(cfg)
+}
@@ -0,0 +1,94 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Tsahi-Elkayam/cloudview/pkg/config"
+	"github.com/Tsahi-Elkayam/cloudview/pkg/models"
+)
+
+func TestConvertFunctionToResource(t *testing.T) {
+	service := NewLambdaService(nil, &config.AWSConfig{Region: "us-east-1"}, logrus.New())
+
+	resource := service.convertFunctionToResource(types.FunctionConfiguration{
+		FunctionName:  aws.String("orders-api"),
+		FunctionArn:   aws.String("arn:aws:lambda:us-east-1:123456789012:function:orders-api"),
+		Runtime:       types.RuntimeNodejs20x,
+		MemorySize:    aws.Int32(512),
+		Timeout:       aws.Int32(30),
+		Architectures: []types.Architecture{types.ArchitectureArm64},
+		CodeSize:      2048,
+		LastModified:  aws.String("2024-03-01T12:30:00.000+0000"),
+		Role:          aws.String("arn:aws:iam::123456789012:role/orders-api"),
+		VpcConfig: &types.VpcConfigResponse{
+			VpcId:            aws.String("vpc-1"),
+			SubnetIds:        []string{"subnet-1", "subnet-2"},
+			SecurityGroupIds: []string{"sg-1"},
+		},
+		Layers: []types.Layer{{Arn: aws.String("arn:aws:lambda:us-east-1:123456789012:layer:shared:3")}},
+	}, "us-east-1")
+
+	assert.Equal(t, "orders-api", resource.ID)
+	assert.Equal(t, string(models.ResourceTypeFunction), resource.Type)
+	assert.Equal(t, models.NativeTypeLambdaFunction, resource.NativeType)
+	assert.Equal(t, "arn:aws:lambda:us-east-1:123456789012:function:orders-api", resource.ARN)
+	assert.Equal(t, "active", resource.Status.State)
+	assert.Equal(t, string(models.HealthHealthy), resource.Status.Health)
+	assert.Equal(t, time.Date(2024, 3, 1, 12, 30, 0, 0, time.UTC), resource.UpdatedAt.UTC())
+	assert.True(t, resource.CreatedAt.IsZero())
+
+	assert.Equal(t, "nodejs20.x", resource.Metadata["runtime"])
+	assert.Equal(t, int32(512), resource.Metadata["memory_size"])
+	assert.Equal(t, int32(30), resource.Metadata["timeout"])
+	assert.Equal(t, []string{"arm64"}, resource.Metadata["architectures"])
+	assert.Equal(t, int64(2048), resource.Metadata["code_size"])
+	assert.Equal(t, "vpc-1", resource.Metadata["vpc_id"])
+	assert.Equal(t, []string{"sg-1"}, resource.Metadata["security_groups"])
+	assert.Equal(t, []string{"arn:aws:lambda:us-east-1:123456789012:layer:shared:3"}, resource.Metadata["layers"])
+}
+
+func TestMapFunctionStateToHealth(t *testing.T) {
+	service := NewLambdaService(nil, &config.AWSConfig{}, logrus.New())
+
+	tests := []struct {
+		state types.State
+		want  models.ResourceHealth
+	}{
+		{"", models.HealthHealthy},
+		{types.StateActive, models.HealthHealthy},
+		{types.StatePending, models.HealthWarning},
+		{types.StateInactive, models.HealthWarning},
+		{types.StateFailed, models.HealthUnhealthy},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.state), func(t *testing.T) {
+			state := service.functionState(tt.state)
+			assert.Equal(t, string(tt.want), service.mapFunctionStateToHealth(state))
+		})
+	}
+}
+
+func TestLambdaFetcherSelection(t *testing.T) {
+	provider, err := NewAWSProvider(&config.AWSConfig{Region: "us-east-1"}, nil)
+	assert.NoError(t, err)
+
+	var lambdaFetcher resourceFetcher
+	for _, fetcher := range provider.resourceFetchers() {
+		if fetcher.nativeType == models.NativeTypeLambdaFunction {
+			lambdaFetcher = fetcher
+		}
+	}
+
+	for _, name := range []string{"lambda", "function", "functions", "AWS::Lambda::Function"} {
+		assert.True(t, lambdaFetcher.selectedBy([]string{name}), name)
+	}
+	assert.False(t, lambdaFetcher.selectedBy([]string{"ec2"}))
+	assert.Contains(t, provider.GetSupportedResourceTypes(), "lambda")
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/rds"
@@ -71,6 +72,65 @@ func (s *RDSService) GetClusters(ctx context.Context, filters shared.ResourceFil
 	return allClusters, nil
 }
 
+// GetDatabaseStatus retrieves the status of an RDS database instance by
+// identifier or ARN
+func (s *RDSService) GetDatabaseStatus(ctx context.Context, identifier string) (*models.ResourceStatus, error) {
+	for _, region := range s.getRegionsToQuery(lookupRegions(identifier)) {
+		regionClient := s.createRegionClient(region)
+	
+		result, err := regionClient.DescribeDBInstances(ctx, &rds.DescribeDBInstancesInput{
+			DBInstanceIdentifier: aws.String(identifier),
+		})
+		if hasErrorCode(err, "DBInstanceNotFound", "InvalidParameterValue") {
+			continue // Try next region
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to look up RDS database %s in %s: %w", identifier, region, err)
+		}
+		if len(result.DBInstances) == 0 {
+			continue
+		}
+	
+		status := aws.ToString(result.DBInstances[0].DBInstanceStatus)
+		return &models.ResourceStatus{
+			State:       status,
+			Health:      s.mapDBStatusToHealth(status),
+			LastChecked: time.Now(),
+		}, nil
+	}
+	
+	return nil, errNotFound("RDS database", identifier)
+}
+
+// GetClusterStatus retrieves the status of an RDS cluster by identifier or ARN
+func (s *RDSService) GetClusterStatus(ctx context.Context, identifier string) (*models.ResourceStatus, error) {
+	for _, region := range s.getRegionsToQuery(lookupRegions(identifier)) {
+		regionClient := s.createRegionClient(region)
+	
+		result, err := regionClient.DescribeDBClusters(ctx, &rds.DescribeDBClustersInput{
+			DBClusterIdentifier: aws.String(identifier),
+		})
+		if hasErrorCode(err, "DBClusterNotFoundFault", "InvalidParameterValue") {
+			continue // Try next region
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to look up RDS cluster %s in %s: %w", identifier, region, err)
+		}
+		if len(result.DBClusters) == 0 {
+			continue
+		}
+	
+		status := aws.ToString(result.DBClusters[0].Status)
+		return &models.ResourceStatus{
+			State:       status,
+			Health:      s.mapDBStatusToHealth(status),
+			LastChecked: time.Now(),
+		}, nil
+	}
+	
+	return nil, errNotFound("RDS cluster", identifier)
+}
+
 // getDatabasesInRegion retrieves databases from a specific region
 func (s *RDSService) getDatabasesInRegion(ctx context.Context, region string, filters shared.ResourceFilters) ([]models.Resource, error) {
 	s.logger.Debugf("Getting RDS databases in region: %s", region)
@@ -93,7 +153,7 @@ func (s *RDSService) getDatabasesInRegion(ctx context.Context, region string, fi
 			resource := s.convertDBInstanceToResource(instance, region)
 			
 			// Apply additional filters
-			if s.matchesFilters(resource, filters) {
+			if filters.Matches(resource) {
 				databases = append(databases, *resource)
 			}
 		}
@@ -125,7 +185,7 @@ func (s *RDSService) getClustersInRegion(ctx context.Context, region string, fil
 			resource := s.convertDBClusterToResource(cluster, region)
 			
 			// Apply additional filters
-			if s.matchesFilters(resource, filters) {
+			if filters.Matches(resource) {
 				clusters = append(clusters, *resource)
 			}
 		}
@@ -143,10 +203,12 @@ func (s *RDSService) convertDBInstanceToResource(instance types.DBInstance, regi
 	resource := models.NewResource(
 		aws.ToString(instance.DBInstanceIdentifier),
 		name,
-		"rds_instance",
+		string(models.ResourceTypeDatabase),
 		"aws",
 		region,
 	)
+	resource.NativeType = models.NativeTypeRDSInstance
+	resource.ARN = aws.ToString(instance.DBInstanceArn)
 	
 	// Update status
 	status := aws.ToString(instance.DBInstanceStatus)
@@ -210,10 +272,12 @@ func (s *RDSService) convertDBClusterToResource(cluster types.DBCluster, region
 	resource := models.NewResource(
 		aws.ToString(cluster.DBClusterIdentifier),
 		name,
-		"rds_cluster",
+		string(models.ResourceTypeDatabase),
 		"aws",
 		region,
 	)
+	resource.NativeType = models.NativeTypeRDSCluster
+	resource.ARN = aws.ToString(cluster.DBClusterArn)
 	
 	// Update status
 	status := aws.ToString(cluster.Status)
@@ -275,7 +339,7 @@ func (s *RDSService) mapDBStatusToHealth(status string) string {
 	switch strings.ToLower(status) {
 	case "available":
 		return string(models.HealthHealthy)
-	case "creating", "starting", "rebooting", "upgrading", "configuring-enhanced-monitoring":
+	case "creating", "starting", "rebooting", "upgrading", "modifying", "backing-up", "maintenance", "renaming", "configuring-enhanced-monitoring":
 		return string(models.HealthWarning)
 	case "stopped", "stopping", "failed", "storage-full", "incompatible-network", "incompatible-restore":
 		return string(models.HealthUnhealthy)
@@ -284,61 +348,6 @@ func (s *RDSService) mapDBStatusToHealth(status string) string {
 	}
 }
 
-// matchesFilters checks if a resource matches the given filters
-func (s *RDSService) matchesFilters(resource *models.Resource, filters shared.ResourceFilters) bool {
-	// Check resource type filter
-	if len(filters.ResourceTypes) > 0 {
-		found := false
-		for _, rt := range filters.ResourceTypes {
-			if strings.EqualFold(rt, "rds") || 
-			   strings.EqualFold(rt, "rds_instance") || 
-			   strings.EqualFold(rt, "rds_cluster") ||
-			   strings.EqualFold(rt, "database") ||
-			   strings.EqualFold(rt, "postgres") ||
-			   strings.EqualFold(rt, "postgresql") ||
-			   strings.EqualFold(rt, "mysql") {
-				found = true
-				break
-			}
-		}
-		if !found {
-			return false
-		}
-	}
-	
-	// Check region filter
-	if len(filters.Regions) > 0 {
-		found := false
-		for _, region := range filters.Regions {
-			if resource.Region == region {
-				found = true
-				break
-			}
-		}
-		if !found {
-			return false
-		}
-	}
-	
-	// Check tag filters
-	for key, value := range filters.Tags {
-		if resourceValue, exists := resource.GetTag(key); !exists || resourceValue != value {
-			return false
-		}
-	}
-	
-	// Check creation time filters
-	if filters.CreatedAfter != nil && resource.CreatedAt.Before(*filters.CreatedAfter) {
-		return false
-	}
-	
-	if filters.CreatedBefore != nil && resource.CreatedAt.After(*filters.CreatedBefore) {
-		return false
-	}
-	
-	return true
-}
-
 // getRegionsToQuery determines which regions to query based on filters and config
 func (s *RDSService) getRegionsToQuery(filterRegions []string) []string {
 	// If specific regions are requested via filters, use those
@@ -3,7 +3,6 @@ package aws
 import (
 	"context"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -56,7 +55,7 @@ func (s *S3Service) GetBuckets(ctx context.Context, filters shared.ResourceFilte
 		}
 		
 		// Apply filters
-		if s.matchesFilters(resource, filters) {
+		if filters.Matches(resource) {
 			buckets = append(buckets, *resource)
 		}
 	}
@@ -72,8 +71,11 @@ func (s *S3Service) GetBucketStatus(ctx context.Context, bucketName string) (*mo
 		Bucket: aws.String(bucketName),
 	})
 	
+	if hasErrorCode(err, "NotFound", "NoSuchBucket") {
+		return nil, errNotFound("S3 bucket", bucketName)
+	}
 	if err != nil {
-		return nil, fmt.Errorf("S3 bucket %s not found or not accessible: %w", bucketName, err)
+		return nil, fmt.Errorf("failed to look up S3 bucket %s: %w", bucketName, err)
 	}
 	
 	return &models.ResourceStatus{
@@ -142,6 +144,8 @@ func (s *S3Service) createBasicBucketResource(bucket types.Bucket) *models.Resou
 		"aws",
 		s.config.Region, // Will be updated with actual region
 	)
+	resource.NativeType = models.NativeTypeS3Bucket
+	resource.ARN = fmt.Sprintf("arn:%s:s3:::%s", partitionForRegion(s.config.Region), bucketName)
 	
 	// Set creation time
 	if bucket.CreationDate != nil {
@@ -270,52 +274,3 @@ func (s *S3Service) getBucketNotification(ctx context.Context, bucketName string
 	
 	return notification, nil
 }
-
-// matchesFilters checks if a resource matches the given filters
-func (s *S3Service) matchesFilters(resource *models.Resource, filters shared.ResourceFilters) bool {
-	// Check resource type filter
-	if len(filters.ResourceTypes) > 0 {
-		found := false
-		for _, rt := range filters.ResourceTypes {
-			if strings.EqualFold(rt, "s3") || strings.EqualFold(rt, "bucket") || strings.EqualFold(rt, "object_storage") {
-				found = true
-				break
-			}
-		}
-		if !found {
-			return false
-		}
-	}
-	
-	// Check region filter
-	if len(filters.Regions) > 0 {
-		found := false
-		for _, region := range filters.Regions {
-			if resource.Region == region {
-				found = true
-				break
-			}
-		}
-		if !found {
-			return false
-		}
-	}
-	
-	// Check tag filters
-	for key, value := range filters.Tags {
-		if resourceValue, exists := resource.GetTag(key); !exists || resourceValue != value {
-			return false
-		}
-	}
-	
-	// Check creation time filters
-	if filters.CreatedAfter != nil && resource.CreatedAt.Before(*filters.CreatedAfter) {
-		return false
-	}
-	
-	if filters.CreatedBefore != nil && resource.CreatedAt.After(*filters.CreatedBefore) {
-		return false
-	}
-	
-	return true
-}
\ No newline at end of file
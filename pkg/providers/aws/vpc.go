@@ -3,7 +3,7 @@ package aws
 import (
 	"context"
 	"fmt"
-	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
@@ -71,6 +71,71 @@ func (s *VPCService) GetSecurityGroups(ctx context.Context, filters shared.Resou
 	return allSecurityGroups, nil
 }
 
+// GetVPCStatus retrieves the status of a VPC by ID or ARN
+func (s *VPCService) GetVPCStatus(ctx context.Context, vpcID string) (*models.ResourceStatus, error) {
+	regions := s.getRegionsToQuery(lookupRegions(vpcID))
+	vpcID = arnResourceName(vpcID)
+	
+	for _, region := range regions {
+		regionClient := s.createRegionClient(region)
+	
+		result, err := regionClient.DescribeVpcs(ctx, &ec2.DescribeVpcsInput{
+			VpcIds: []string{vpcID},
+		})
+		if hasErrorCode(err, "InvalidVpcID.NotFound", "InvalidVpcID.Malformed") {
+			continue // Try next region
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to look up VPC %s in %s: %w", vpcID, region, err)
+		}
+		if len(result.Vpcs) == 0 {
+			continue
+		}
+	
+		state := result.Vpcs[0].State
+		return &models.ResourceStatus{
+			State:       string(state),
+			Health:      s.mapVPCStateToHealth(state),
+			LastChecked: time.Now(),
+		}, nil
+	}
+	
+	return nil, errNotFound("VPC", vpcID)
+}
+
+// GetSecurityGroupStatus retrieves the status of a security group by ID or
+// ARN. Security
+// groups have no lifecycle state, so an existing group is available.
+func (s *VPCService) GetSecurityGroupStatus(ctx context.Context, groupID string) (*models.ResourceStatus, error) {
+	regions := s.getRegionsToQuery(lookupRegions(groupID))
+	groupID = arnResourceName(groupID)
+	
+	for _, region := range regions {
+		regionClient := s.createRegionClient(region)
+	
+		result, err := regionClient.DescribeSecurityGroups(ctx, &ec2.DescribeSecurityGroupsInput{
+			GroupIds: []string{groupID},
+		})
+		if hasErrorCode(err, "InvalidGroup.NotFound", "InvalidGroupId.Malformed") {
+			continue // Try next region
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to look up security group %s in %s: %w", groupID, region, err)
+		}
+		if len(result.SecurityGroups) == 0 {
+			continue
+		}
+	
+		return &models.ResourceStatus{
+			State:       "available",
+			Health:      string(models.HealthHealthy),
+			LastChecked: time.Now(),
+		}, nil
+	}
+	
+	return nil, errNotFound("security group", groupID)
+}
+
 // getVPCsInRegion retrieves VPCs from a specific region
 func (s *VPCService) getVPCsInRegion(ctx context.Context, region string, filters shared.ResourceFilters) ([]models.Resource, error) {
 	s.logger.Debugf("Getting VPCs in region: %s", region)
@@ -93,7 +158,7 @@ func (s *VPCService) getVPCsInRegion(ctx context.Context, region string, filters
 			resource := s.convertVPCToResource(vpc, region)
 			
 			// Apply additional filters
-			if s.matchesFilters(resource, filters) {
+			if filters.Matches(resource) {
 				vpcs = append(vpcs, *resource)
 			}
 		}
@@ -125,7 +190,7 @@ func (s *VPCService) getSecurityGroupsInRegion(ctx context.Context, region strin
 			resource := s.convertSecurityGroupToResource(sg, region)
 			
 			// Apply additional filters
-			if s.matchesFilters(resource, filters) {
+			if filters.Matches(resource) {
 				securityGroups = append(securityGroups, *resource)
 			}
 		}
@@ -149,10 +214,12 @@ func (s *VPCService) convertVPCToResource(vpc types.Vpc, region string) *models.
 	resource := models.NewResource(
 		aws.ToString(vpc.VpcId),
 		name,
-		"vpc",
+		string(models.ResourceTypeVPC),
 		"aws",
 		region,
 	)
+	resource.NativeType = models.NativeTypeVPC
+	resource.ARN = buildARN("ec2", region, aws.ToString(vpc.OwnerId), "vpc/"+aws.ToString(vpc.VpcId))
 	
 	// Update status
 	resource.UpdateStatus(
@@ -191,10 +258,12 @@ func (s *VPCService) convertSecurityGroupToResource(sg types.SecurityGroup, regi
 	resource := models.NewResource(
 		aws.ToString(sg.GroupId),
 		aws.ToString(sg.GroupName),
-		"security_group",
+		string(models.ResourceTypeSecurityGroup),
 		"aws",
 		region,
 	)
+	resource.NativeType = models.NativeTypeEC2SecurityGroup
+	resource.ARN = buildARN("ec2", region, aws.ToString(sg.OwnerId), "security-group/"+aws.ToString(sg.GroupId))
 	
 	resource.UpdateStatus("available", string(models.HealthHealthy))
 	
@@ -272,49 +341,6 @@ func (s *VPCService) mapVPCStateToHealth(state types.VpcState) string {
 	}
 }
 
-// matchesFilters checks if a resource matches the given filters
-func (s *VPCService) matchesFilters(resource *models.Resource, filters shared.ResourceFilters) bool {
-	// Check resource type filter
-	if len(filters.ResourceTypes) > 0 {
-		found := false
-		for _, rt := range filters.ResourceTypes {
-			if strings.EqualFold(rt, "vpc") || 
-			   strings.EqualFold(rt, "network") ||
-			   strings.EqualFold(rt, "security_group") ||
-			   strings.EqualFold(rt, "firewall") {
-				found = true
-				break
-			}
-		}
-		if !found {
-			return false
-		}
-	}
-	
-	// Check region filter
-	if len(filters.Regions) > 0 {
-		found := false
-		for _, region := range filters.Regions {
-			if resource.Region == region {
-				found = true
-				break
-			}
-		}
-		if !found {
-			return false
-		}
-	}
-	
-	// Check tag filters
-	for key, value := range filters.Tags {
-		if resourceValue, exists := resource.GetTag(key); !exists || resourceValue != value {
-			return false
-		}
-	}
-	
-	return true
-}
-
 // getRegionsToQuery determines which regions to query based on filters and config
 func (s *VPCService) getRegionsToQuery(filterRegions []string) []string {
 	// If specific regions are requested via filters, use those
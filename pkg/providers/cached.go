@@ -0,0 +1,197 @@
+package providers
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Tsahi-Elkayam/cloudview/pkg/cache"
+	"github.com/Tsahi-Elkayam/cloudview/pkg/models"
+	"github.com/Tsahi-Elkayam/cloudview/pkg/types"
+)
+
+// AccountIdentifier is implemented by providers that can report the account they are authenticated against
+type AccountIdentifier interface {
+	AccountID() string
+}
+
+// CachedProvider wraps a CloudProvider and caches resource discovery results
+type CachedProvider struct {
+	CloudProvider
+
+	store   cache.Store
+	ttl     time.Duration
+	regions []string
+	refresh bool
+	logger  *logrus.Logger
+}
+
+// resourceCacheKey identifies a cached resource query
+type resourceCacheKey struct {
+	Provider     string                `json:"provider"`
+	Account      string                `json:"account"`
+	Regions      []string              `json:"regions"`
+	ResourceType string                `json:"resource_type"`
+	Filters      types.ResourceFilters `json:"filters"`
+}
+
+// NewCachedProvider creates a caching wrapper around a provider.
+// regions are the provider's configured regions, used in the cache key when
+// a query does not restrict regions itself.
+func NewCachedProvider(provider CloudProvider, store cache.Store, ttl time.Duration, regions []string, logger *logrus.Logger) *CachedProvider {
+	if logger == nil {
+		logger = logrus.New()
+	}
+
+	return &CachedProvider{
+		CloudProvider: provider,
+		store:         store,
+		ttl:           ttl,
+		regions:       regions,
+		logger:        logger,
+	}
+}
+
+// SetRefresh makes the provider bypass cached results while still storing fresh ones
+func (p *CachedProvider) SetRefresh(refresh bool) {
+	p.refresh = refresh
+}
+
+// GetResources retrieves resources, serving them from the cache when possible
+func (p *CachedProvider) GetResources(ctx context.Context, filters types.ResourceFilters) ([]models.Resource, error) {
+	return p.cached("", filters, func() ([]models.Resource, error) {
+		return p.CloudProvider.GetResources(ctx, filters)
+	})
+}
+
+// GetResourcesByType retrieves resources of a type, serving them from the cache when possible
+func (p *CachedProvider) GetResourcesByType(ctx context.Context, resourceType string, filters types.ResourceFilters) ([]models.Resource, error) {
+	return p.cached(resourceType, filters, func() ([]models.Resource, error) {
+		return p.CloudProvider.GetResourcesByType(ctx, resourceType, filters)
+	})
+}
+
+// StreamResources streams resources, replaying them from the cache when
+// possible. On a miss the provider's stream is forwarded as it arrives and
+// cached once it completes, so every streamed resource is also held in
+// memory until then; streaming with caching disabled keeps memory flat.
+func (p *CachedProvider) StreamResources(ctx context.Context, filters types.ResourceFilters) (<-chan types.DiscoveryEvent, error) {
+	key, err := p.cacheKey("", filters)
+	if err != nil {
+		p.logger.Debugf("Skipping cache for %s: %v", p.Name(), err)
+		return StreamResources(ctx, p.CloudProvider, filters)
+	}
+
+	if resources, ok := p.lookup(key); ok {
+		return streamSlice(ctx, p.Name(), "cache", resources), nil
+	}
+
+	upstream, err := StreamResources(ctx, p.CloudProvider, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make(chan types.DiscoveryEvent)
+	go func() {
+		defer close(events)
+
+		var resources []models.Resource
+		complete := true
+		for event := range upstream {
+			if event.Type == types.DiscoveryEventResource {
+				resources = append(resources, *event.Resource)
+			}
+			if complete && !types.SendEvent(ctx, events, event) {
+				// Keep draining so the provider's goroutines can exit
+				complete = false
+			}
+		}
+
+		// Don't cache results cut short by cancellation
+		if complete && ctx.Err() == nil {
+			p.save(key, resources)
+		}
+	}()
+	return events, nil
+}
+
+// cached looks up a query in the cache and falls back to fetch on a miss
+func (p *CachedProvider) cached(resourceType string, filters types.ResourceFilters, fetch func() ([]models.Resource, error)) ([]models.Resource, error) {
+	key, err := p.cacheKey(resourceType, filters)
+	if err != nil {
+		p.logger.Debugf("Skipping cache for %s: %v", p.Name(), err)
+		return fetch()
+	}
+
+	if resources, ok := p.lookup(key); ok {
+		return resources, nil
+	}
+
+	resources, err := fetch()
+	if err != nil {
+		return nil, err
+	}
+
+	p.save(key, resources)
+	return resources, nil
+}
+
+// lookup returns cached resources for a key unless refreshing
+func (p *CachedProvider) lookup(key string) ([]models.Resource, bool) {
+	if p.refresh {
+		return nil, false
+	}
+
+	data, ok := p.store.Get(key)
+	if !ok {
+		return nil, false
+	}
+
+	var resources []models.Resource
+	if err := json.Unmarshal(data, &resources); err != nil {
+		p.logger.Debugf("Discarding unreadable cache entry for %s", p.Name())
+		return nil, false
+	}
+
+	p.logger.Debugf("Cache hit for %s (%d resources)", p.Name(), len(resources))
+	return resources, true
+}
+
+// save caches resources under a key
+func (p *CachedProvider) save(key string, resources []models.Resource) {
+	data, err := json.Marshal(resources)
+	if err != nil {
+		p.logger.Debugf("Failed to encode resources for cache: %v", err)
+		return
+	}
+
+	if err := p.store.Set(key, data, p.ttl); err != nil {
+		p.logger.Warnf("Failed to write cache entry for %s: %v", p.Name(), err)
+	}
+}
+
+// cacheKey builds the cache key for a query
+func (p *CachedProvider) cacheKey(resourceType string, filters types.ResourceFilters) (string, error) {
+	regions := filters.Regions
+	if len(regions) == 0 {
+		regions = p.regions
+	}
+	regions = append([]string(nil), regions...)
+	sort.Strings(regions)
+
+	var account string
+	if identifier, ok := p.CloudProvider.(AccountIdentifier); ok {
+		account = identifier.AccountID()
+	}
+
+	return cache.Key(resourceCacheKey{
+		Provider:     p.Name(),
+		Account:      account,
+		Regions:      regions,
+		ResourceType: resourceType,
+		Filters:      filters,
+	})
+}
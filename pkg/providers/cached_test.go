@@ -0,0 +1,63 @@
+package providers
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Tsahi-Elkayam/cloudview/pkg/cache"
+	"github.com/Tsahi-Elkayam/cloudview/pkg/types"
+	"github.com/Tsahi-Elkayam/cloudview/test/mocks"
+)
+
+func TestCachedProvider(t *testing.T) {
+	ctx := context.Background()
+
+	mockProvider := mocks.NewMockAWSProvider()
+	mockProvider.SetAuthenticated(true)
+	mockProvider.AddResource(mocks.CreateMockEC2Instance("i-1234567890abcdef0", "test-instance", "us-east-1", "running"))
+
+	provider := NewCachedProvider(mockProvider, cache.NewMemoryStore(0), time.Minute, []string{"us-east-1"}, logrus.New())
+
+	// First query populates the cache
+	resources, err := provider.GetResources(ctx, types.ResourceFilters{})
+	require.NoError(t, err)
+	assert.Len(t, resources, 1)
+
+	// Later queries are served from the cache even if the provider fails
+	mockProvider.SetError("GetResources", errors.New("api unavailable"))
+	resources, err = provider.GetResources(ctx, types.ResourceFilters{})
+	require.NoError(t, err)
+	assert.Len(t, resources, 1)
+	assert.Equal(t, "i-1234567890abcdef0", resources[0].ID)
+
+	// Different filters use a different cache entry
+	_, err = provider.GetResources(ctx, types.ResourceFilters{Regions: []string{"us-west-2"}})
+	assert.Error(t, err)
+
+	// Refresh bypasses the cache
+	provider.SetRefresh(true)
+	_, err = provider.GetResources(ctx, types.ResourceFilters{})
+	assert.Error(t, err)
+}
+
+func TestCachedProviderExpiry(t *testing.T) {
+	ctx := context.Background()
+
+	mockProvider := mocks.NewMockAWSProvider()
+	mockProvider.AddResource(mocks.CreateMockS3Bucket("test-bucket", "us-east-1"))
+
+	provider := NewCachedProvider(mockProvider, cache.NewMemoryStore(0), -time.Second, nil, logrus.New())
+
+	_, err := provider.GetResourcesByType(ctx, "s3", types.ResourceFilters{})
+	require.NoError(t, err)
+
+	mockProvider.SetError("GetResourcesByType", errors.New("api unavailable"))
+	_, err = provider.GetResourcesByType(ctx, "s3", types.ResourceFilters{})
+	assert.Error(t, err)
+}
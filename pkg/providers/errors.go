@@ -3,6 +3,8 @@ package providers
 import (
 	"errors"
 	"fmt"
+
+	"github.com/Tsahi-Elkayam/cloudview/pkg/types"
 )
 
 // Common provider errors
@@ -14,7 +16,7 @@ var (
 	ErrProviderNotAuthenticated = errors.New("provider not authenticated")
 	
 	// ErrResourceNotFound is returned when a resource is not found
-	ErrResourceNotFound = errors.New("resource not found")
+	ErrResourceNotFound = types.ErrResourceNotFound
 	
 	// ErrInvalidConfiguration is returned when provider configuration is invalid
 	ErrInvalidConfiguration = errors.New("invalid provider configuration")
@@ -29,13 +31,13 @@ var (
 	ErrRegionNotSupported = errors.New("region not supported")
 	
 	// ErrRateLimitExceeded is returned when API rate limits are exceeded
-	ErrRateLimitExceeded = errors.New("rate limit exceeded")
+	ErrRateLimitExceeded = types.ErrRateLimitExceeded
 	
 	// ErrPermissionDenied is returned when access is denied
 	ErrPermissionDenied = errors.New("permission denied")
 	
 	// ErrServiceUnavailable is returned when a service is unavailable
-	ErrServiceUnavailable = errors.New("service unavailable")
+	ErrServiceUnavailable = types.ErrServiceUnavailable
 )
 
 // ProviderError represents a provider-specific error
@@ -0,0 +1,69 @@
+package providers
+
+import (
+	"context"
+
+	"github.com/Tsahi-Elkayam/cloudview/pkg/models"
+	"github.com/Tsahi-Elkayam/cloudview/pkg/types"
+)
+
+// StreamingProvider is implemented by providers that can report resources in
+// batches, as each service finishes a region, instead of after every service
+// has been queried
+type StreamingProvider interface {
+	// StreamResources starts discovery and returns a channel of events. The
+	// channel is closed once every service has completed. Callers must drain
+	// the channel or cancel ctx.
+	StreamResources(ctx context.Context, filters types.ResourceFilters) (<-chan types.DiscoveryEvent, error)
+}
+
+// StreamResources streams a provider's resources. Providers that don't
+// implement StreamingProvider are queried with GetResources, and their
+// resources are emitted followed by a single completion event.
+func StreamResources(ctx context.Context, provider CloudProvider, filters types.ResourceFilters) (<-chan types.DiscoveryEvent, error) {
+	if streaming, ok := provider.(StreamingProvider); ok {
+		return streaming.StreamResources(ctx, filters)
+	}
+
+	resources, err := provider.GetResources(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+	return streamSlice(ctx, provider.Name(), provider.Name(), resources), nil
+}
+
+// streamSlice emits already discovered resources as a stream for a single service
+func streamSlice(ctx context.Context, provider, service string, resources []models.Resource) <-chan types.DiscoveryEvent {
+	events := make(chan types.DiscoveryEvent)
+	go func() {
+		defer close(events)
+		if !types.SendResources(ctx, events, provider, service, resources) {
+			return
+		}
+		types.SendEvent(ctx, events, types.DiscoveryEvent{
+			Type:     types.DiscoveryEventServiceDone,
+			Provider: provider,
+			Service:  service,
+			Count:    len(resources),
+		})
+	}()
+	return events
+}
+
+// CollectResources drains a discovery stream into a slice. Errors from failed
+// services are returned separately so callers can decide how to report them.
+func CollectResources(events <-chan types.DiscoveryEvent) ([]models.Resource, []error) {
+	var resources []models.Resource
+	var errs []error
+	for event := range events {
+		switch event.Type {
+		case types.DiscoveryEventResource:
+			resources = append(resources, *event.Resource)
+		case types.DiscoveryEventServiceDone:
+			if event.Err != nil {
+				errs = append(errs, event.Err)
+			}
+		}
+	}
+	return resources, errs
+}
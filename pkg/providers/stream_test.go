@@ -0,0 +1,100 @@
+package providers
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Tsahi-Elkayam/cloudview/pkg/cache"
+	"github.com/Tsahi-Elkayam/cloudview/pkg/models"
+	"github.com/Tsahi-Elkayam/cloudview/pkg/types"
+	"github.com/Tsahi-Elkayam/cloudview/test/mocks"
+)
+
+func TestStreamResourcesFallback(t *testing.T) {
+	ctx := context.Background()
+
+	mockProvider := mocks.NewMockAWSProvider()
+	mockProvider.AddResource(mocks.CreateMockEC2Instance("i-1234567890abcdef0", "web", "us-east-1", "running"))
+	mockProvider.AddResource(mocks.CreateMockS3Bucket("test-bucket", "us-east-1"))
+
+	events, err := StreamResources(ctx, mockProvider, types.ResourceFilters{})
+	require.NoError(t, err)
+
+	var received []types.DiscoveryEvent
+	for event := range events {
+		received = append(received, event)
+	}
+
+	// Providers without streaming support emit their resources, then finish
+	require.Len(t, received, 3)
+	assert.Equal(t, types.DiscoveryEventResource, received[0].Type)
+	assert.Equal(t, types.DiscoveryEventResource, received[1].Type)
+	assert.Equal(t, types.DiscoveryEventServiceDone, received[2].Type)
+	assert.Equal(t, 2, received[2].Count)
+	assert.NoError(t, received[2].Err)
+
+	mockProvider.SetError("GetResources", errors.New("api unavailable"))
+	_, err = StreamResources(ctx, mockProvider, types.ResourceFilters{})
+	assert.Error(t, err)
+}
+
+func TestStreamResourcesCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	events := streamSlice(ctx, "aws", "EC2 instances", make([]models.Resource, 10))
+	<-events
+	cancel()
+
+	// The stream closes instead of blocking on the remaining events
+	for range events {
+	}
+}
+
+func TestCollectResources(t *testing.T) {
+	events := make(chan types.DiscoveryEvent, 4)
+	events <- types.DiscoveryEvent{Type: types.DiscoveryEventResource, Resource: &models.Resource{ID: "i-1"}}
+	events <- types.DiscoveryEvent{Type: types.DiscoveryEventServiceDone, Service: "EC2 instances", Count: 1}
+	events <- types.DiscoveryEvent{Type: types.DiscoveryEventServiceDone, Service: "S3 buckets", Err: errors.New("access denied")}
+	close(events)
+
+	resources, errs := CollectResources(events)
+	require.Len(t, resources, 1)
+	assert.Equal(t, "i-1", resources[0].ID)
+	require.Len(t, errs, 1)
+	assert.EqualError(t, errs[0], "access denied")
+}
+
+func TestCachedProviderStreamResources(t *testing.T) {
+	ctx := context.Background()
+
+	mockProvider := mocks.NewMockAWSProvider()
+	mockProvider.AddResource(mocks.CreateMockEC2Instance("i-1234567890abcdef0", "web", "us-east-1", "running"))
+
+	provider := NewCachedProvider(mockProvider, cache.NewMemoryStore(0), time.Minute, []string{"us-east-1"}, logrus.New())
+
+	// The first stream is forwarded from the provider and cached
+	events, err := provider.StreamResources(ctx, types.ResourceFilters{})
+	require.NoError(t, err)
+	resources, errs := CollectResources(events)
+	assert.Empty(t, errs)
+	assert.Len(t, resources, 1)
+
+	// Later streams are replayed from the cache
+	mockProvider.SetError("GetResources", errors.New("api unavailable"))
+	events, err = provider.StreamResources(ctx, types.ResourceFilters{})
+	require.NoError(t, err)
+	resources, _ = CollectResources(events)
+	require.Len(t, resources, 1)
+	assert.Equal(t, "i-1234567890abcdef0", resources[0].ID)
+
+	// Streamed and non-streamed queries share cache entries
+	resources, err = provider.GetResources(ctx, types.ResourceFilters{})
+	require.NoError(t, err)
+	assert.Len(t, resources, 1)
+}
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"context"
+
+	"github.com/Tsahi-Elkayam/cloudview/pkg/models"
+)
+
+// DiscoveryEventType identifies the kind of a discovery event
+type DiscoveryEventType string
+
+const (
+	// DiscoveryEventResource carries a single discovered resource
+	DiscoveryEventResource DiscoveryEventType = "resource"
+	// DiscoveryEventServiceDone reports that a service has been fully queried
+	DiscoveryEventServiceDone DiscoveryEventType = "service_done"
+)
+
+// DiscoveryEvent is emitted by streaming resource discovery
+type DiscoveryEvent struct {
+	Type     DiscoveryEventType
+	Provider string
+	Service  string
+
+	// Resource is set for resource events
+	Resource *models.Resource
+
+	// Count and Err are set for service completion events: the number of
+	// resources the service returned and why it failed, if it did
+	Count int
+	Err   error
+}
+
+// SendEvent sends an event unless ctx is cancelled first, and reports
+// whether it was sent
+func SendEvent(ctx context.Context, events chan<- DiscoveryEvent, event DiscoveryEvent) bool {
+	select {
+	case events <- event:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// SendResources sends a resource event for each resource and returns false
+// if ctx was cancelled before all of them were sent
+func SendResources(ctx context.Context, events chan<- DiscoveryEvent, provider, service string, resources []models.Resource) bool {
+	for i := range resources {
+		event := DiscoveryEvent{
+			Type:     DiscoveryEventResource,
+			Provider: provider,
+			Service:  service,
+			Resource: &resources[i],
+		}
+		if !SendEvent(ctx, events, event) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,15 @@
+package types
+
+import "errors"
+
+// ErrResourceNotFound is returned by provider lookups when no resource
+// matches, as opposed to lookups that failed. It is shared so provider
+// implementations can return it without importing the providers package.
+var ErrResourceNotFound = errors.New("resource not found")
+
+// ErrRateLimitExceeded and ErrServiceUnavailable mark failures that may
+// succeed if retried later, such as throttled API calls
+var (
+	ErrRateLimitExceeded  = errors.New("rate limit exceeded")
+	ErrServiceUnavailable = errors.New("service unavailable")
+)
@@ -0,0 +1,985 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Tsahi-Elkayam/cloudview/pkg/models"
+)
+
+// FilterExpression is a parsed boolean filter expression evaluated against
+// resources, for example:
+//
+//	type == "virtual_machine" && metadata.instance_type =~ "^t3" && !has(tags.Owner) && created_at < now-90d
+//
+// Fields are id, name, type, native_type, arn, provider, region, status
+// (status.state), status.health, created_at, updated_at, tags.<key> and
+// metadata.<path>; keys that aren't identifiers can be quoted as
+// tags["Cost Center"].
+// Operators are ==, !=, <, <=, >, >=, =~ and !~ (regular expressions),
+// in [...] and not in [...], combined with &&, || and ! (or and, or, not)
+// and parentheses. has(field) tests that a field is set and
+// contains(field, value) tests list membership or substrings. Comparisons
+// against a list field match if any element matches. Time fields can be
+// compared with "2024-01-31", RFC 3339 timestamps or now, now-90d, now+12h
+// (units s, m, h, d, w).
+type FilterExpression struct {
+	source string
+	root   exprNode
+}
+
+// ParseFilterExpression parses a filter expression
+func ParseFilterExpression(source string) (*FilterExpression, error) {
+	tokens, err := lexExpression(source)
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter expression: %w", err)
+	}
+
+	p := &exprParser{tokens: tokens}
+	root, err := p.parseOr()
+	if err == nil && p.peek().kind != tokenEOF {
+		err = p.errorf("unexpected %s", p.peek())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter expression: %w", err)
+	}
+
+	return &FilterExpression{source: strings.TrimSpace(source), root: root}, nil
+}
+
+// String returns the expression source
+func (e *FilterExpression) String() string {
+	if e == nil {
+		return ""
+	}
+	return e.source
+}
+
+// Matches reports whether a resource satisfies the expression. A nil
+// expression matches every resource.
+func (e *FilterExpression) Matches(resource *models.Resource) bool {
+	if e == nil || e.root == nil {
+		return true
+	}
+	return e.root.eval(resource, time.Now())
+}
+
+// MarshalJSON encodes the expression as its source text
+func (e *FilterExpression) MarshalJSON() ([]byte, error) {
+	return json.Marshal(e.String())
+}
+
+// UnmarshalJSON parses an expression from its source text
+func (e *FilterExpression) UnmarshalJSON(data []byte) error {
+	var source string
+	if err := json.Unmarshal(data, &source); err != nil {
+		return err
+	}
+
+	parsed, err := ParseFilterExpression(source)
+	if err != nil {
+		return err
+	}
+
+	*e = *parsed
+	return nil
+}
+
+// FilterTerm is a condition every matching resource must satisfy, suitable
+// for pushing down to provider APIs: the field equals one of Values, or,
+// when Exists is set, the field is present
+type FilterTerm struct {
+	Field  string
+	Values []string
+	Exists bool
+}
+
+// Terms returns the equality, membership and has() conditions joined by &&
+// at the top level of the expression. Resources matching the expression
+// always satisfy every term, so providers can use them as server-side
+// filters and still evaluate the full expression client-side.
+func (e *FilterExpression) Terms() []FilterTerm {
+	if e == nil || e.root == nil {
+		return nil
+	}
+
+	var terms []FilterTerm
+	for _, node := range conjuncts(e.root) {
+		switch n := node.(type) {
+		case *compareNode:
+			if n.op == "==" && n.value.kind == literalString {
+				terms = append(terms, FilterTerm{Field: n.field.name(), Values: []string{n.value.str}})
+			}
+		case *inNode:
+			if n.negated {
+				continue
+			}
+			values := make([]string, 0, len(n.values))
+			for _, value := range n.values {
+				if value.kind != literalString {
+					values = nil
+					break
+				}
+				values = append(values, value.str)
+			}
+			if len(values) > 0 {
+				terms = append(terms, FilterTerm{Field: n.field.name(), Values: values})
+			}
+		case *hasNode:
+			terms = append(terms, FilterTerm{Field: n.field.name(), Exists: true})
+		}
+	}
+
+	return terms
+}
+
+// conjuncts flattens the top-level && chain of an expression
+func conjuncts(node exprNode) []exprNode {
+	if and, ok := node.(*andNode); ok {
+		return append(conjuncts(and.left), conjuncts(and.right)...)
+	}
+	return []exprNode{node}
+}
+
+// Tokens
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenString
+	tokenNumber
+	tokenOperator
+)
+
+type token struct {
+	kind  tokenKind
+	text  string
+	value string
+	pos   int
+}
+
+func (t token) String() string {
+	switch t.kind {
+	case tokenEOF:
+		return "end of expression"
+	case tokenString:
+		return strconv.Quote(t.value)
+	default:
+		return fmt.Sprintf("%q", t.text)
+	}
+}
+
+// operators are matched longest first
+var operators = []string{"&&", "||", "==", "!=", "=~", "!~", "<=", ">=", "<", ">", "!", "(", ")", "[", "]", ","}
+
+// lexExpression splits an expression into tokens
+func lexExpression(source string) ([]token, error) {
+	var tokens []token
+
+	for i := 0; i < len(source); {
+		c := source[i]
+
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+
+		case c == '"' || c == '\'':
+			start := i
+			var b strings.Builder
+			i++
+			for {
+				if i >= len(source) {
+					return nil, fmt.Errorf("unterminated string at position %d", start+1)
+				}
+				if source[i] == c {
+					i++
+					break
+				}
+				if source[i] == '\\' && i+1 < len(source) {
+					i++
+				}
+				b.WriteByte(source[i])
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenString, text: source[start:i], value: b.String(), pos: start})
+
+		case isDigit(c) || (c == '-' && i+1 < len(source) && isDigit(source[i+1])):
+			start := i
+			i++
+			for i < len(source) && (isDigit(source[i]) || source[i] == '.') {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenNumber, text: source[start:i], value: source[start:i], pos: start})
+
+		case isIdentStart(c):
+			start := i
+			for i < len(source) && isIdentChar(source[i]) {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenIdent, text: source[start:i], value: source[start:i], pos: start})
+
+		default:
+			matched := false
+			for _, op := range operators {
+				if strings.HasPrefix(source[i:], op) {
+					tokens = append(tokens, token{kind: tokenOperator, text: op, value: op, pos: i})
+					i += len(op)
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return nil, fmt.Errorf("unexpected character %q at position %d", c, i+1)
+			}
+		}
+	}
+
+	return append(tokens, token{kind: tokenEOF, pos: len(source)}), nil
+}
+
+func isDigit(c byte) bool { return c >= '0' && c <= '9' }
+
+func isIdentStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// isIdentChar allows the characters found in tag keys such as
+// aws:cloudformation:stack-name, and relative times such as now-90d
+func isIdentChar(c byte) bool {
+	return isIdentStart(c) || isDigit(c) || c == '.' || c == '-' || c == ':' || c == '/' || c == '@' || c == '+'
+}
+
+// Parser
+
+type exprParser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *exprParser) peek() token { return p.tokens[p.pos] }
+
+func (p *exprParser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *exprParser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("%s at position %d", fmt.Sprintf(format, args...), p.peek().pos+1)
+}
+
+// accept consumes the next token if it is one of the given operators or keywords
+func (p *exprParser) accept(texts ...string) bool {
+	t := p.peek()
+	if t.kind != tokenOperator && t.kind != tokenIdent {
+		return false
+	}
+	for _, text := range texts {
+		if strings.EqualFold(t.text, text) {
+			p.pos++
+			return true
+		}
+	}
+	return false
+}
+
+func (p *exprParser) expect(text string) error {
+	if !p.accept(text) {
+		return p.errorf("expected %q but found %s", text, p.peek())
+	}
+	return nil
+}
+
+func (p *exprParser) parseOr() (exprNode, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.accept("||", "or") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &orNode{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *exprParser) parseAnd() (exprNode, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.accept("&&", "and") {
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &andNode{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *exprParser) parseUnary() (exprNode, error) {
+	if p.accept("!", "not") {
+		expr, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &notNode{expr: expr}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *exprParser) parsePrimary() (exprNode, error) {
+	if p.accept("(") {
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		return expr, nil
+	}
+
+	t := p.peek()
+	if t.kind != tokenIdent {
+		return nil, p.errorf("expected a field or function but found %s", t)
+	}
+
+	// Functions
+	if p.tokens[p.pos+1].text == "(" {
+		return p.parseFunction()
+	}
+
+	field, err := p.parseField()
+	if err != nil {
+		return nil, err
+	}
+
+	// Membership
+	negated := false
+	if p.peek().kind == tokenIdent && strings.EqualFold(p.peek().text, "not") &&
+		strings.EqualFold(p.tokens[p.pos+1].text, "in") {
+		p.pos++
+		negated = true
+	}
+	if p.accept("in") {
+		values, err := p.parseList()
+		if err != nil {
+			return nil, err
+		}
+		return &inNode{field: field, values: values, negated: negated}, nil
+	}
+
+	// Comparison
+	op := p.peek()
+	switch op.text {
+	case "==", "!=", "<", "<=", ">", ">=", "=~", "!~":
+		if op.kind != tokenOperator {
+			break
+		}
+		p.pos++
+
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+
+		node := &compareNode{field: field, op: op.text, value: value}
+		if op.text == "=~" || op.text == "!~" {
+			if value.kind != literalString {
+				return nil, fmt.Errorf("%s requires a quoted regular expression at position %d", op.text, op.pos+1)
+			}
+			node.pattern, err = regexp.Compile(value.str)
+			if err != nil {
+				return nil, fmt.Errorf("invalid regular expression %q: %w", value.str, err)
+			}
+		}
+		return node, nil
+	}
+
+	// A bare field is true when it holds a true boolean
+	return &compareNode{field: field, op: "==", value: literal{kind: literalBool, b: true}}, nil
+}
+
+func (p *exprParser) parseFunction() (exprNode, error) {
+	name := strings.ToLower(p.next().text)
+	p.next() // (
+
+	field, err := p.parseField()
+	if err != nil {
+		return nil, err
+	}
+
+	var node exprNode
+	switch name {
+	case "has", "exists":
+		node = &hasNode{field: field}
+	case "contains":
+		if err := p.expect(","); err != nil {
+			return nil, err
+		}
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		node = &containsNode{field: field, value: value}
+	default:
+		return nil, fmt.Errorf("unknown function %q (supported: has, contains)", name)
+	}
+
+	if err := p.expect(")"); err != nil {
+		return nil, err
+	}
+	return node, nil
+}
+
+// parseField parses a dotted field path with optional ["quoted key"] segments
+func (p *exprParser) parseField() (fieldRef, error) {
+	t := p.peek()
+	if t.kind != tokenIdent {
+		return fieldRef{}, p.errorf("expected a field but found %s", t)
+	}
+	p.pos++
+
+	path := strings.Split(t.text, ".")
+	for p.accept("[") {
+		key := p.next()
+		if key.kind != tokenString {
+			return fieldRef{}, fmt.Errorf("expected a quoted key at position %d", key.pos+1)
+		}
+		path = append(path, key.value)
+		if err := p.expect("]"); err != nil {
+			return fieldRef{}, err
+		}
+	}
+
+	for _, segment := range path {
+		if segment == "" {
+			return fieldRef{}, fmt.Errorf("invalid field %q at position %d", t.text, t.pos+1)
+		}
+	}
+
+	field := fieldRef{path: path}
+	if !field.known() {
+		return fieldRef{}, fmt.Errorf("unknown field %q at position %d (expected id, name, type, native_type, arn, provider, region, status, created_at, updated_at, tags.<key> or metadata.<key>)", t.text, t.pos+1)
+	}
+	return field, nil
+}
+
+// relativeTimePattern matches now, now-90d, now+12h
+var relativeTimePattern = regexp.MustCompile(`^now(?:([+-])(\d+)([smhdw]))?$`)
+
+func (p *exprParser) parseValue() (literal, error) {
+	t := p.next()
+
+	switch t.kind {
+	case tokenString:
+		return literal{kind: literalString, str: t.value}, nil
+	case tokenNumber:
+		f, err := strconv.ParseFloat(t.value, 64)
+		if err != nil {
+			return literal{}, fmt.Errorf("invalid number %q at position %d", t.text, t.pos+1)
+		}
+		return literal{kind: literalNumber, num: f, str: t.value}, nil
+	case tokenIdent:
+		lower := strings.ToLower(t.text)
+		if lower == "true" || lower == "false" {
+			return literal{kind: literalBool, b: lower == "true", str: lower}, nil
+		}
+		if m := relativeTimePattern.FindStringSubmatch(lower); m != nil {
+			lit := literal{kind: literalRelativeTime, str: lower}
+			if m[1] != "" {
+				n, _ := strconv.Atoi(m[2])
+				lit.offset = time.Duration(n) * durationUnits[m[3]]
+				if m[1] == "-" {
+					lit.offset = -lit.offset
+				}
+			}
+			return lit, nil
+		}
+		return literal{}, fmt.Errorf("expected a value but found %s at position %d (quote strings)", t, t.pos+1)
+	}
+
+	return literal{}, fmt.Errorf("expected a value but found %s at position %d", t, t.pos+1)
+}
+
+// durationUnits are the units accepted in relative times
+var durationUnits = map[string]time.Duration{
+	"s": time.Second,
+	"m": time.Minute,
+	"h": time.Hour,
+	"d": 24 * time.Hour,
+	"w": 7 * 24 * time.Hour,
+}
+
+func (p *exprParser) parseList() ([]literal, error) {
+	if err := p.expect("["); err != nil {
+		return nil, err
+	}
+
+	var values []literal
+	for !p.accept("]") {
+		if len(values) > 0 {
+			if err := p.expect(","); err != nil {
+				return nil, err
+			}
+		}
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+
+	if len(values) == 0 {
+		return nil, p.errorf("empty list")
+	}
+	return values, nil
+}
+
+// Literals
+
+type literalKind int
+
+const (
+	literalString literalKind = iota
+	literalNumber
+	literalBool
+	literalRelativeTime
+)
+
+type literal struct {
+	kind   literalKind
+	str    string
+	num    float64
+	b      bool
+	offset time.Duration
+}
+
+// Fields
+
+// fieldRef is a path to a resource field
+type fieldRef struct {
+	path []string
+}
+
+// name returns the field's canonical dotted name
+func (f fieldRef) name() string {
+	if len(f.path) == 1 && strings.EqualFold(f.path[0], "status") {
+		return "status.state"
+	}
+	return strings.Join(f.path, ".")
+}
+
+// known reports whether the field refers to a resource attribute
+func (f fieldRef) known() bool {
+	switch strings.ToLower(f.path[0]) {
+	case "id", "name", "type", "native_type", "arn", "provider", "region", "created_at", "updated_at":
+		return len(f.path) == 1
+	case "status":
+		return len(f.path) == 1 || (len(f.path) == 2 && (f.path[1] == "state" || f.path[1] == "health"))
+	case "tags", "metadata":
+		return true
+	}
+	return false
+}
+
+// resolve returns the normalized field value and whether it is set
+func (f fieldRef) resolve(resource *models.Resource) (interface{}, bool) {
+	switch strings.ToLower(f.path[0]) {
+	case "id":
+		return resource.ID, resource.ID != ""
+	case "name":
+		return resource.Name, resource.Name != ""
+	case "type":
+		return resource.Type, resource.Type != ""
+	case "native_type":
+		return resource.NativeType, resource.NativeType != ""
+	case "arn":
+		return resource.ARN, resource.ARN != ""
+	case "provider":
+		return resource.Provider, resource.Provider != ""
+	case "region":
+		return resource.Region, resource.Region != ""
+	case "status":
+		if len(f.path) == 2 && f.path[1] == "health" {
+			return resource.Status.Health, resource.Status.Health != ""
+		}
+		return resource.Status.State, resource.Status.State != ""
+	case "created_at":
+		return resource.CreatedAt, !resource.CreatedAt.IsZero()
+	case "updated_at":
+		return resource.UpdatedAt, !resource.UpdatedAt.IsZero()
+	case "tags":
+		if len(f.path) == 1 {
+			return resource.Tags, len(resource.Tags) > 0
+		}
+		value, ok := resource.Tags[strings.Join(f.path[1:], ".")]
+		return value, ok
+	case "metadata":
+		if len(f.path) == 1 {
+			return resource.Metadata, len(resource.Metadata) > 0
+		}
+		value, ok := resource.Metadata[f.path[1]]
+		if !ok {
+			return nil, false
+		}
+		for _, segment := range f.path[2:] {
+			if value, ok = lookupKey(value, segment); !ok {
+				return nil, false
+			}
+		}
+		value = normalizeValue(value)
+		return value, !isEmpty(value)
+	}
+	return nil, false
+}
+
+// lookupKey indexes a map with string keys
+func lookupKey(value interface{}, key string) (interface{}, bool) {
+	v := reflect.ValueOf(value)
+	for v.IsValid() && (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return nil, false
+		}
+		v = v.Elem()
+	}
+
+	if !v.IsValid() || v.Kind() != reflect.Map || v.Type().Key().Kind() != reflect.String {
+		return nil, false
+	}
+
+	item := v.MapIndex(reflect.ValueOf(key).Convert(v.Type().Key()))
+	if !item.IsValid() {
+		return nil, false
+	}
+	return item.Interface(), true
+}
+
+// normalizeValue converts metadata values to string, float64, bool,
+// time.Time or []interface{} so they can be compared uniformly
+func normalizeValue(value interface{}) interface{} {
+	v := reflect.ValueOf(value)
+	for v.IsValid() && (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return nil
+	}
+
+	if t, ok := v.Interface().(time.Time); ok {
+		return t
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String()
+	case reflect.Bool:
+		return v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		return v.Float()
+	case reflect.Slice, reflect.Array:
+		items := make([]interface{}, v.Len())
+		for i := range items {
+			items[i] = normalizeValue(v.Index(i).Interface())
+		}
+		return items
+	}
+
+	if s, ok := v.Interface().(fmt.Stringer); ok {
+		return s.String()
+	}
+	return v.Interface()
+}
+
+// isEmpty reports whether a normalized value is unset
+func isEmpty(value interface{}) bool {
+	switch typed := value.(type) {
+	case nil:
+		return true
+	case string:
+		return typed == ""
+	case []interface{}:
+		return len(typed) == 0
+	}
+
+	v := reflect.ValueOf(value)
+	return v.Kind() == reflect.Map && v.Len() == 0
+}
+
+// Nodes
+
+type exprNode interface {
+	eval(resource *models.Resource, now time.Time) bool
+}
+
+type andNode struct{ left, right exprNode }
+
+func (n *andNode) eval(r *models.Resource, now time.Time) bool {
+	return n.left.eval(r, now) && n.right.eval(r, now)
+}
+
+type orNode struct{ left, right exprNode }
+
+func (n *orNode) eval(r *models.Resource, now time.Time) bool {
+	return n.left.eval(r, now) || n.right.eval(r, now)
+}
+
+type notNode struct{ expr exprNode }
+
+func (n *notNode) eval(r *models.Resource, now time.Time) bool {
+	return !n.expr.eval(r, now)
+}
+
+type hasNode struct{ field fieldRef }
+
+func (n *hasNode) eval(r *models.Resource, now time.Time) bool {
+	_, ok := n.field.resolve(r)
+	return ok
+}
+
+type containsNode struct {
+	field fieldRef
+	value literal
+}
+
+func (n *containsNode) eval(r *models.Resource, now time.Time) bool {
+	value, ok := n.field.resolve(r)
+	if !ok {
+		return false
+	}
+	if s, isString := value.(string); isString && n.value.kind == literalString {
+		return strings.Contains(s, n.value.str)
+	}
+	return anyValue(value, func(v interface{}) bool { return equalValue(v, n.value, now) })
+}
+
+type inNode struct {
+	field   fieldRef
+	values  []literal
+	negated bool
+}
+
+func (n *inNode) eval(r *models.Resource, now time.Time) bool {
+	value, ok := n.field.resolve(r)
+	matched := ok && anyValue(value, func(v interface{}) bool {
+		for _, lit := range n.values {
+			if equalValue(v, lit, now) {
+				return true
+			}
+		}
+		return false
+	})
+	return matched != n.negated
+}
+
+type compareNode struct {
+	field   fieldRef
+	op      string
+	value   literal
+	pattern *regexp.Regexp
+}
+
+func (n *compareNode) eval(r *models.Resource, now time.Time) bool {
+	value, ok := n.field.resolve(r)
+
+	// != and !~ are the negation of == and =~, so they match missing fields
+	switch n.op {
+	case "!=":
+		return !(ok && anyValue(value, func(v interface{}) bool { return equalValue(v, n.value, now) }))
+	case "!~":
+		return !(ok && anyValue(value, func(v interface{}) bool { return n.pattern.MatchString(valueString(v)) }))
+	}
+
+	if !ok {
+		return false
+	}
+
+	return anyValue(value, func(v interface{}) bool {
+		switch n.op {
+		case "==":
+			return equalValue(v, n.value, now)
+		case "=~":
+			return n.pattern.MatchString(valueString(v))
+		}
+
+		c, comparable := compareValue(v, n.value, now)
+		if !comparable {
+			return false
+		}
+		switch n.op {
+		case "<":
+			return c < 0
+		case "<=":
+			return c <= 0
+		case ">":
+			return c > 0
+		case ">=":
+			return c >= 0
+		}
+		return false
+	})
+}
+
+// anyValue applies test to each element of a list value, or to a scalar value
+func anyValue(value interface{}, test func(interface{}) bool) bool {
+	if items, ok := value.([]interface{}); ok {
+		for _, item := range items {
+			if test(item) {
+				return true
+			}
+		}
+		return false
+	}
+	return test(value)
+}
+
+// equalValue compares a value to a literal for equality
+func equalValue(value interface{}, lit literal, now time.Time) bool {
+	c, ok := compareValue(value, lit, now)
+	return ok && c == 0
+}
+
+// compareValue orders a normalized value against a literal, converting the
+// value to the literal's kind where possible
+func compareValue(value interface{}, lit literal, now time.Time) (int, bool) {
+	switch lit.kind {
+	case literalRelativeTime:
+		t, ok := toTime(value)
+		if !ok {
+			return 0, false
+		}
+		return t.Compare(now.Add(lit.offset)), true
+
+	case literalNumber:
+		f, ok := toNumber(value)
+		if !ok {
+			return 0, false
+		}
+		return compareFloats(f, lit.num), true
+
+	case literalBool:
+		b, ok := value.(bool)
+		if !ok {
+			s, isString := value.(string)
+			if !isString {
+				return 0, false
+			}
+			parsed, err := strconv.ParseBool(s)
+			if err != nil {
+				return 0, false
+			}
+			b = parsed
+		}
+		if b == lit.b {
+			return 0, true
+		}
+		if !b {
+			return -1, true
+		}
+		return 1, true
+	}
+
+	// String literals adapt to the field's type
+	switch typed := value.(type) {
+	case time.Time:
+		t, ok := parseTime(lit.str)
+		if !ok {
+			return 0, false
+		}
+		return typed.Compare(t), true
+	case float64:
+		f, err := strconv.ParseFloat(lit.str, 64)
+		if err != nil {
+			return 0, false
+		}
+		return compareFloats(typed, f), true
+	case bool:
+		b, err := strconv.ParseBool(lit.str)
+		if err != nil {
+			return 0, false
+		}
+		return compareValue(typed, literal{kind: literalBool, b: b}, now)
+	case string:
+		return strings.Compare(typed, lit.str), true
+	}
+
+	return strings.Compare(valueString(value), lit.str), true
+}
+
+func compareFloats(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// toTime converts a time value or a timestamp string to time.Time
+func toTime(value interface{}) (time.Time, bool) {
+	switch typed := value.(type) {
+	case time.Time:
+		return typed, !typed.IsZero()
+	case string:
+		return parseTime(typed)
+	}
+	return time.Time{}, false
+}
+
+// toNumber converts a number or numeric string to float64
+func toNumber(value interface{}) (float64, bool) {
+	switch typed := value.(type) {
+	case float64:
+		return typed, true
+	case string:
+		f, err := strconv.ParseFloat(typed, 64)
+		return f, err == nil
+	}
+	return 0, false
+}
+
+// parseTime parses RFC 3339 timestamps and YYYY-MM-DD dates
+func parseTime(s string) (time.Time, bool) {
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05", "2006-01-02"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// valueString renders a normalized value for regular expression matching
+func valueString(value interface{}) string {
+	switch typed := value.(type) {
+	case string:
+		return typed
+	case float64:
+		return strconv.FormatFloat(typed, 'f', -1, 64)
+	case time.Time:
+		return typed.UTC().Format(time.RFC3339)
+	case nil:
+		return ""
+	}
+	return fmt.Sprintf("%v", value)
+}
@@ -0,0 +1,132 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Tsahi-Elkayam/cloudview/pkg/models"
+)
+
+func expressionTestResource() *models.Resource {
+	created := time.Now().Add(-120 * 24 * time.Hour)
+	return &models.Resource{
+		ID:        "i-1234567890abcdef0",
+		Name:      "web-server",
+		Type:      "virtual_machine",
+		Provider:  "aws",
+		Region:    "us-east-1",
+		Status:    models.ResourceStatus{State: "running", Health: "healthy"},
+		CreatedAt: created,
+		Tags:      map[string]string{"Environment": "prod", "Cost Center": "42"},
+		Metadata: map[string]interface{}{
+			"instance_type":   "t3.micro",
+			"cpu_cores":       int32(2),
+			"public_ip":       "",
+			"security_groups": []string{"sg-1", "sg-2"},
+			"encryption":      map[string]interface{}{"enabled": true, "algorithm": "AES256"},
+		},
+	}
+}
+
+func TestFilterExpressionMatches(t *testing.T) {
+	resource := expressionTestResource()
+
+	tests := []struct {
+		expression string
+		want       bool
+	}{
+		{`type == "virtual_machine"`, true},
+		{`type != "virtual_machine"`, false},
+		{`metadata.instance_type =~ "^t3"`, true},
+		{`metadata.instance_type !~ "^t3"`, false},
+		{`!has(tags.Owner)`, true},
+		{`has(tags.Environment)`, true},
+		{`has(metadata.public_ip)`, false},
+		{`created_at < now-90d`, true},
+		{`created_at > now-30d`, false},
+		{`created_at >= "2020-01-01"`, true},
+		{`type == "virtual_machine" && metadata.instance_type =~ "^t3" && !has(tags.Owner) && created_at < now-90d`, true},
+		{`region == "eu-west-1" || tags.Environment == "prod"`, true},
+		{`region == "eu-west-1" || (tags.Environment == "prod" && status == "stopped")`, false},
+		{`not (status == "stopped") and status.health == 'healthy'`, true},
+		{`metadata.cpu_cores >= 2`, true},
+		{`metadata.cpu_cores > 2`, false},
+		{`metadata.security_groups == "sg-2"`, true},
+		{`contains(metadata.security_groups, "sg-3")`, false},
+		{`contains(name, "web")`, true},
+		{`region in ["us-east-1", "us-west-2"]`, true},
+		{`region not in ["us-east-1"]`, false},
+		{`metadata.encryption.enabled`, true},
+		{`metadata.encryption.algorithm == "AES256"`, true},
+		{`tags["Cost Center"] == "42"`, true},
+		{`tags.Owner != "alice"`, true},
+		{`metadata.missing.key == "x"`, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			expression, err := ParseFilterExpression(tt.expression)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, expression.Matches(resource))
+		})
+	}
+}
+
+func TestParseFilterExpressionErrors(t *testing.T) {
+	for _, expression := range []string{
+		``,
+		`type ==`,
+		`type == virtual_machine`,
+		`(type == "vm"`,
+		`type == "vm" &&`,
+		`unknown == "x"`,
+		`name =~ "("`,
+		`name =~ 5`,
+		`length(name) > 3`,
+		`region in []`,
+		`name == "unterminated`,
+		`name # "x"`,
+	} {
+		t.Run(expression, func(t *testing.T) {
+			_, err := ParseFilterExpression(expression)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestFilterExpressionTerms(t *testing.T) {
+	expression, err := ParseFilterExpression(
+		`status == "running" && has(tags.Owner) && region in ["us-east-1", "us-west-2"] && (name == "a" || name == "b") && metadata.cpu_cores == 2`)
+	require.NoError(t, err)
+
+	assert.Equal(t, []FilterTerm{
+		{Field: "status.state", Values: []string{"running"}},
+		{Field: "tags.Owner", Exists: true},
+		{Field: "region", Values: []string{"us-east-1", "us-west-2"}},
+	}, expression.Terms())
+
+	// Nothing can be pushed down from a top-level disjunction
+	expression, err = ParseFilterExpression(`status == "running" || has(tags.Owner)`)
+	require.NoError(t, err)
+	assert.Empty(t, expression.Terms())
+}
+
+func TestFilterExpressionJSON(t *testing.T) {
+	filters := ResourceFilters{}
+	filters.Expression, _ = ParseFilterExpression(`region == "us-east-1"`)
+
+	data, err := json.Marshal(filters)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"expression": "region == \"us-east-1\""}`, string(data))
+
+	var decoded ResourceFilters
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.True(t, decoded.Expression.Matches(&models.Resource{Region: "us-east-1"}))
+
+	// A nil expression matches everything
+	assert.True(t, ResourceFilters{}.Expression.Matches(&models.Resource{}))
+}
@@ -7,9 +7,29 @@ type ResourceFilters struct {
 	Regions       []string          `json:"regions,omitempty"`
 	ResourceTypes []string          `json:"resource_types,omitempty"`
 	Tags          map[string]string `json:"tags,omitempty"`
+	TagFilters    []TagFilter       `json:"tag_filters,omitempty"`
 	Status        []string          `json:"status,omitempty"`
 	CreatedAfter  *time.Time        `json:"created_after,omitempty"`
 	CreatedBefore *time.Time        `json:"created_before,omitempty"`
+	Expression    *FilterExpression `json:"expression,omitempty"`
+}
+
+// MatchesTags reports whether tags satisfy both the exact Tags map and
+// every TagFilter
+func (f ResourceFilters) MatchesTags(tags map[string]string) bool {
+	for key, value := range f.Tags {
+		if tagValue, exists := tags[key]; !exists || tagValue != value {
+			return false
+		}
+	}
+
+	for _, filter := range f.TagFilters {
+		if !filter.Matches(tags) {
+			return false
+		}
+	}
+
+	return true
 }
 
 // CostPeriod defines the time period for cost queries
@@ -0,0 +1,106 @@
+package types
+
+import (
+	"strings"
+	"time"
+
+	"github.com/Tsahi-Elkayam/cloudview/pkg/models"
+)
+
+// GlobalRegion is the region of resources that aren't tied to a region, such as IAM
+const GlobalRegion = "global"
+
+// Matches reports whether a resource satisfies every filter. It is the
+// single definition of filter semantics used by all providers:
+//
+//   - Regions: the resource region is one of the regions (case-insensitive);
+//     global resources match any region
+//   - ResourceTypes: the resource is selected by one of the normalized types,
+//     native types or aliases (see models.ResolveResourceType)
+//   - Status: the resource state equals one of the statuses as the provider
+//     reports it (case-insensitive), or belongs to a requested status class
+//     such as "active" (see StatusClasses)
+//   - Tags and TagFilters: see MatchesTags
+//   - CreatedAfter/CreatedBefore: inclusive bounds; resources without a
+//     known creation time don't match
+//   - Expression: see FilterExpression
+func (f ResourceFilters) Matches(resource *models.Resource) bool {
+	return f.MatchesRegion(resource.Region) &&
+		f.MatchesType(resource) &&
+		f.MatchesStatus(resource.Status.State) &&
+		f.MatchesTags(resource.Tags) &&
+		f.MatchesCreatedAt(resource.CreatedAt) &&
+		f.Expression.Matches(resource)
+}
+
+// MatchesRegion reports whether a region satisfies the region filter
+func (f ResourceFilters) MatchesRegion(region string) bool {
+	if len(f.Regions) == 0 || strings.EqualFold(region, GlobalRegion) {
+		return true
+	}
+
+	for _, candidate := range f.Regions {
+		if strings.EqualFold(candidate, region) {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchesType reports whether a resource satisfies the type filter
+func (f ResourceFilters) MatchesType(resource *models.Resource) bool {
+	if len(f.ResourceTypes) == 0 {
+		return true
+	}
+
+	for _, requested := range f.ResourceTypes {
+		if resource.MatchesType(requested) {
+			return true
+		}
+	}
+	return false
+}
+
+// StatusClasses are the statuses that select every provider state normalizing
+// to a class, so "active" matches an EC2 "running" instance as well as an RDS
+// "available" database. Any other status is matched literally.
+var StatusClasses = map[string]models.ResourceState{
+	"active":   models.StateRunning,
+	"inactive": models.StateStopped,
+}
+
+// MatchesStatus reports whether a resource state satisfies the status filter
+func (f ResourceFilters) MatchesStatus(state string) bool {
+	if len(f.Status) == 0 {
+		return true
+	}
+
+	normalized := models.GetStateFromString(strings.ToLower(state))
+	for _, requested := range f.Status {
+		if strings.EqualFold(requested, state) {
+			return true
+		}
+		if class, ok := StatusClasses[strings.ToLower(requested)]; ok && class == normalized {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchesCreatedAt reports whether a creation time satisfies the time filters
+func (f ResourceFilters) MatchesCreatedAt(createdAt time.Time) bool {
+	if f.CreatedAfter == nil && f.CreatedBefore == nil {
+		return true
+	}
+	if createdAt.IsZero() {
+		return false
+	}
+
+	if f.CreatedAfter != nil && createdAt.Before(*f.CreatedAfter) {
+		return false
+	}
+	if f.CreatedBefore != nil && createdAt.After(*f.CreatedBefore) {
+		return false
+	}
+	return true
+}
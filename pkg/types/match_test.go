@@ -0,0 +1,65 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Tsahi-Elkayam/cloudview/pkg/models"
+)
+
+func TestResourceFiltersMatches(t *testing.T) {
+	created := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	before := created.Add(-24 * time.Hour)
+	after := created.Add(24 * time.Hour)
+	expression, _ := ParseFilterExpression(`metadata.engine == "postgres"`)
+
+	database := &models.Resource{
+		ID:         "db-1",
+		Type:       "database",
+		NativeType: models.NativeTypeRDSInstance,
+		Region:     "us-east-1",
+		Status:     models.ResourceStatus{State: "available"},
+		Tags:       map[string]string{"Team": "data"},
+		CreatedAt:  created,
+		Metadata:   map[string]interface{}{"engine": "postgres"},
+	}
+	role := &models.Resource{ID: "role-1", Type: "role", NativeType: models.NativeTypeIAMRole, Region: "global"}
+
+	tests := []struct {
+		name     string
+		filters  ResourceFilters
+		resource *models.Resource
+		want     bool
+	}{
+		{"no filters", ResourceFilters{}, database, true},
+		{"region", ResourceFilters{Regions: []string{"us-west-2", "US-EAST-1"}}, database, true},
+		{"other region", ResourceFilters{Regions: []string{"us-west-2"}}, database, false},
+		{"global resources match any region", ResourceFilters{Regions: []string{"us-west-2"}}, role, true},
+		{"type", ResourceFilters{ResourceTypes: []string{"database"}}, database, true},
+		{"type alias", ResourceFilters{ResourceTypes: []string{"RDS"}}, database, true},
+		{"native type", ResourceFilters{ResourceTypes: []string{"AWS::RDS::DBInstance"}}, database, true},
+		{"group alias", ResourceFilters{ResourceTypes: []string{"iam"}}, role, true},
+		{"other type", ResourceFilters{ResourceTypes: []string{"ec2", "rds_cluster"}}, database, false},
+		{"raw status", ResourceFilters{Status: []string{"available"}}, database, true},
+		{"status names match literally", ResourceFilters{Status: []string{"running"}}, database, false},
+		{"status class", ResourceFilters{Status: []string{"active"}}, database, true},
+		{"other status class", ResourceFilters{Status: []string{"inactive"}}, database, false},
+		{"other status", ResourceFilters{Status: []string{"stopped"}}, database, false},
+		{"tag", ResourceFilters{Tags: map[string]string{"Team": "data"}}, database, true},
+		{"tag filter", ResourceFilters{TagFilters: []TagFilter{{Key: "Team", Absent: true}}}, database, false},
+		{"created after", ResourceFilters{CreatedAfter: &before}, database, true},
+		{"created before", ResourceFilters{CreatedBefore: &before}, database, false},
+		{"created range inclusive", ResourceFilters{CreatedAfter: &created, CreatedBefore: &created}, database, true},
+		{"unknown creation time", ResourceFilters{CreatedBefore: &after}, role, false},
+		{"expression", ResourceFilters{Expression: expression}, database, true},
+		{"expression mismatch", ResourceFilters{Expression: expression}, role, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.filters.Matches(tt.resource))
+		})
+	}
+}
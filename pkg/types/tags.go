@@ -0,0 +1,163 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// TagFilter matches resources by tag presence or value. With no Values or
+// Regex it only requires the tag to exist, or with Absent to be missing.
+type TagFilter struct {
+	Key string `json:"key"`
+	// Values are alternatives; each may be a glob using * and ?
+	Values []string `json:"values,omitempty"`
+	// Regex is a regular expression the value must match
+	Regex  string `json:"regex,omitempty"`
+	Absent bool   `json:"absent,omitempty"`
+}
+
+// ParseTagFilter parses a --tag argument:
+//
+//	Owner              tag exists
+//	!Owner             tag is absent
+//	Env=prod           exact value
+//	Env=prod|staging   any of several values
+//	Name=web-*         glob (* and ?)
+//	Name=~^web-\d+$    regular expression
+func ParseTagFilter(s string) (TagFilter, error) {
+	s = strings.TrimSpace(s)
+
+	if strings.HasPrefix(s, "!") {
+		key := strings.TrimSpace(s[1:])
+		if key == "" || strings.Contains(key, "=") {
+			return TagFilter{}, fmt.Errorf("invalid tag filter: %s (expected !key)", s)
+		}
+		return TagFilter{Key: key, Absent: true}, nil
+	}
+
+	key, value, hasValue := strings.Cut(s, "=")
+	key = strings.TrimSpace(key)
+	if key == "" {
+		return TagFilter{}, fmt.Errorf("invalid tag filter: %s (expected key, !key, key=value, key=a|b or key=~regex)", s)
+	}
+
+	filter := TagFilter{Key: key}
+	if !hasValue {
+		return filter, nil
+	}
+
+	if strings.HasPrefix(value, "~") {
+		filter.Regex = value[1:]
+		if _, err := compileTagPattern(filter.Regex); err != nil {
+			return TagFilter{}, fmt.Errorf("invalid tag filter: %s: %w", s, err)
+		}
+		return filter, nil
+	}
+
+	// --tag used to split on commas, so "Env=prod,Team=backend" would now
+	// silently look for the value "prod,Team=backend"
+	if parts := strings.Split(value, ","); len(parts) > 1 {
+		for _, part := range parts[1:] {
+			if strings.Contains(part, "=") {
+				return TagFilter{}, fmt.Errorf("invalid tag filter: %s (repeat --tag for each tag, e.g. --tag %s --tag %s; use key=~regex to match a value containing \",key=\")", s, key+"="+parts[0], strings.TrimSpace(strings.Join(parts[1:], ",")))
+			}
+		}
+	}
+
+	filter.Values = strings.Split(value, "|")
+	return filter, nil
+}
+
+// String returns the filter in --tag syntax
+func (f TagFilter) String() string {
+	switch {
+	case f.Absent:
+		return "!" + f.Key
+	case f.Regex != "":
+		return f.Key + "=~" + f.Regex
+	case len(f.Values) > 0:
+		return f.Key + "=" + strings.Join(f.Values, "|")
+	}
+	return f.Key
+}
+
+// IsExact reports whether the filter requires a single literal value
+func (f TagFilter) IsExact() bool {
+	return !f.Absent && f.Regex == "" && len(f.Values) == 1 && !IsGlob(f.Values[0])
+}
+
+// Matches reports whether tags satisfy the filter
+func (f TagFilter) Matches(tags map[string]string) bool {
+	value, exists := tags[f.Key]
+
+	switch {
+	case f.Absent:
+		return !exists
+	case !exists:
+		return false
+	case f.Regex != "":
+		pattern, err := compileTagPattern(f.Regex)
+		return err == nil && pattern.MatchString(value)
+	case len(f.Values) == 0:
+		return true
+	}
+
+	for _, candidate := range f.Values {
+		if MatchGlob(candidate, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsGlob reports whether a value contains glob wildcards
+func IsGlob(value string) bool {
+	return strings.ContainsAny(value, "*?")
+}
+
+// MatchGlob matches value against a pattern where * matches any run of
+// characters and ? a single character. Patterns without wildcards must
+// match exactly.
+func MatchGlob(pattern, value string) bool {
+	if !IsGlob(pattern) {
+		return pattern == value
+	}
+
+	var b strings.Builder
+	b.WriteString("^")
+	for _, r := range pattern {
+		switch r {
+		case '*':
+			b.WriteString(".*")
+		case '?':
+			b.WriteString(".")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	b.WriteString("$")
+
+	compiled, err := compileTagPattern(b.String())
+	return err == nil && compiled.MatchString(value)
+}
+
+// tagPatterns caches compiled tag patterns, since the same filter is
+// evaluated against every resource
+var tagPatterns sync.Map
+
+// compileTagPattern compiles a regular expression, reusing earlier results
+func compileTagPattern(expr string) (*regexp.Regexp, error) {
+	if cached, ok := tagPatterns.Load(expr); ok {
+		return cached.(*regexp.Regexp), nil
+	}
+
+	compiled, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	tagPatterns.Store(expr, compiled)
+	return compiled, nil
+}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTagFilterMatches(t *testing.T) {
+	tags := map[string]string{"Environment": "staging", "Name": "web-01", "Owner": ""}
+
+	tests := []struct {
+		filter string
+		want   bool
+	}{
+		{"Owner", true},
+		{"Team", false},
+		{"!Team", true},
+		{"!Owner", false},
+		{"Environment=staging", true},
+		{"Environment=prod", false},
+		{"Environment=prod|staging", true},
+		{"Name=web-*", true},
+		{"Name=web-?", false},
+		{"Name=web-??", true},
+		{"Name=*api*", false},
+		{`Name=~^web-\d+$`, true},
+		{"Name=~^api", false},
+		{"Team=~.*", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.filter, func(t *testing.T) {
+			filter, err := ParseTagFilter(tt.filter)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, filter.Matches(tags))
+			assert.Equal(t, tt.filter, filter.String())
+		})
+	}
+}
+
+func TestParseTagFilterErrors(t *testing.T) {
+	for _, s := range []string{"", "=value", "!", "!Key=value", "Name=~(", "Environment=prod,Team=backend"} {
+		_, err := ParseTagFilter(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestResourceFiltersMatchesTags(t *testing.T) {
+	filters := ResourceFilters{
+		Tags:       map[string]string{"Team": "backend"},
+		TagFilters: []TagFilter{{Key: "Owner", Absent: true}},
+	}
+
+	assert.True(t, filters.MatchesTags(map[string]string{"Team": "backend"}))
+	assert.False(t, filters.MatchesTags(map[string]string{"Team": "backend", "Owner": "alice"}))
+	assert.False(t, filters.MatchesTags(map[string]string{"Team": "frontend"}))
+	assert.False(t, filters.MatchesTags(nil))
+	assert.True(t, ResourceFilters{}.MatchesTags(nil))
+}
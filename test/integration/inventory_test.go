@@ -85,6 +85,13 @@ func testInventoryWithMockProvider(t *testing.T, ctx context.Context, logger *lo
 	require.NoError(t, err)
 	assert.Len(t, tagResources, 1) // Only EC2 instance has Environment=test tag
 
+	// Test filtering by expression
+	expression, err := types.ParseFilterExpression(`type == "ec2" && has(tags.Environment) && metadata.instance_type =~ "^t3"`)
+	require.NoError(t, err)
+	exprResources, err := mockProvider.GetResources(ctx, types.ResourceFilters{Expression: expression})
+	require.NoError(t, err)
+	assert.Len(t, exprResources, 1)
+
 	// Test getting resource status
 	status, err := mockProvider.GetResourceStatus(ctx, "i-1234567890abcdef0")
 	require.NoError(t, err)
@@ -2,7 +2,6 @@ package mocks
 
 import (
 	"context"
-	"fmt"
 	"time"
 
 	"github.com/Tsahi-Elkayam/cloudview/pkg/config"
@@ -11,7 +10,7 @@ import (
 )
 
 // ErrResourceNotFound is returned when a resource is not found
-var ErrResourceNotFound = fmt.Errorf("resource not found")
+var ErrResourceNotFound = types.ErrResourceNotFound
 
 // MockAWSProvider implements the CloudProvider interface for testing
 type MockAWSProvider struct {
@@ -78,7 +77,7 @@ func (m *MockAWSProvider) GetResources(ctx context.Context, filters types.Resour
 	// Apply basic filtering
 	var filteredResources []models.Resource
 	for _, resource := range m.resources {
-		if m.matchesFilters(resource, filters) {
+		if filters.Matches(&resource) {
 			filteredResources = append(filteredResources, resource)
 		}
 	}
@@ -93,7 +92,7 @@ func (m *MockAWSProvider) GetResourcesByType(ctx context.Context, resourceType s
 	
 	var filteredResources []models.Resource
 	for _, resource := range m.resources {
-		if resource.Type == resourceType && m.matchesFilters(resource, filters) {
+		if resource.MatchesType(resourceType) && filters.Matches(&resource) {
 			filteredResources = append(filteredResources, resource)
 		}
 	}
@@ -125,70 +124,6 @@ func (m *MockAWSProvider) ValidateConfig(config config.ProviderConfig) error {
 func (m *MockAWSProvider) GetSupportedResourceTypes() []string {
 	return []string{"ec2", "s3", "lambda"}
 }
-
-// matchesFilters checks if a resource matches the given filters
-func (m *MockAWSProvider) matchesFilters(resource models.Resource, filters types.ResourceFilters) bool {
-	// Check regions
-	if len(filters.Regions) > 0 {
-		found := false
-		for _, region := range filters.Regions {
-			if resource.Region == region {
-				found = true
-				break
-			}
-		}
-		if !found {
-			return false
-		}
-	}
-	
-	// Check resource types
-	if len(filters.ResourceTypes) > 0 {
-		found := false
-		for _, rt := range filters.ResourceTypes {
-			if resource.Type == rt {
-				found = true
-				break
-			}
-		}
-		if !found {
-			return false
-		}
-	}
-	
-	// Check tags
-	for key, value := range filters.Tags {
-		if resourceValue, exists := resource.Tags[key]; !exists || resourceValue != value {
-			return false
-		}
-	}
-	
-	// Check status
-	if len(filters.Status) > 0 {
-		found := false
-		for _, status := range filters.Status {
-			if resource.Status.State == status {
-				found = true
-				break
-			}
-		}
-		if !found {
-			return false
-		}
-	}
-	
-	// Check creation time
-	if filters.CreatedAfter != nil && resource.CreatedAt.Before(*filters.CreatedAfter) {
-		return false
-	}
-	
-	if filters.CreatedBefore != nil && resource.CreatedAt.After(*filters.CreatedBefore) {
-		return false
-	}
-	
-	return true
-}
-
 // Placeholder implementations for future features
 
 func (m *MockAWSProvider) GetCosts(ctx context.Context, period types.CostPeriod) ([]models.Cost, error) {